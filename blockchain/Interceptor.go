@@ -19,38 +19,150 @@
 package blockchain
 
 import (
+	"bytes"
+	"encoding/json"
 	"io"
 	"strings"
+	"sync"
+
+	"github.com/dapperlabs/flow-playground-api/model"
 )
 
-type Logs []string
+// cadenceLogPrefix is the prefix of the messages the emulator logs for the Cadence program logs.
+const cadenceLogPrefix = "Cadence log: "
+
+// cadenceLogLevel is the level the emulator logs the Cadence program logs at.
+const cadenceLogLevel = "debug"
+
+// Logs are the Cadence logs of an execution in the order they were logged.
+type Logs []model.LogEntry
+
+// logsFromMessages creates the logs of a script from the messages the program logged.
+func logsFromMessages(messages []string) Logs {
+	logs := make(Logs, len(messages))
+	for i, message := range messages {
+		logs[i] = model.LogEntry{
+			Level:   cadenceLogLevel,
+			Message: message,
+			Line:    i + 1,
+		}
+	}
+	return logs
+}
+
+// logLine is a zerolog line written by the emulator.
+type logLine struct {
+	Level           string `json:"level"`
+	Message         string `json:"message"`
+	TxID            string `json:"txID"`
+	ComputationUsed uint64 `json:"computationUsed"`
+	MemoryEstimate  uint64 `json:"memoryEstimate"`
+}
 
-// Interceptor is used to intercept Cadence runtime logs from the emulator
+// Interceptor is used to intercept Cadence runtime logs from the emulator.
+//
+// The logs are only collected while a capture is active, the emulator executes the transactions
+// one at a time, and reports the transaction ID with the execution result after the transaction logs.
+// It's safe to write to the interceptor concurrently, as the scripts are executed concurrently.
 type Interceptor struct {
-	logs Logs
+	mutex    sync.Mutex
+	captures map[*logCapture]struct{}
+	// lastTxID and lastUsage are of the last executed transaction.
+	lastTxID  string
+	lastUsage model.TransactionUsage
 }
 
 var _ io.Writer = &Interceptor{}
 
 func NewInterceptor() *Interceptor {
-	return &Interceptor{}
+	return &Interceptor{
+		captures: make(map[*logCapture]struct{}),
+	}
 }
 
 func (logger *Interceptor) Write(p []byte) (n int, err error) {
-	logger.logs = append(logger.logs, string(p))
+	var line logLine
+	if err := json.Unmarshal(bytes.TrimSpace(p), &line); err != nil {
+		// the lines which are not JSON are not emulator logs
+		return len(p), nil
+	}
+
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+
+	if strings.HasPrefix(line.Message, cadenceLogPrefix) {
+		for capture := range logger.captures {
+			capture.entries = append(capture.entries, model.LogEntry{
+				Level:   line.Level,
+				Message: strings.TrimPrefix(line.Message, cadenceLogPrefix),
+				Line:    len(capture.entries) + 1,
+			})
+		}
+		return len(p), nil
+	}
+
+	if line.TxID != "" {
+		logger.lastTxID = line.TxID
+		logger.lastUsage = model.TransactionUsage{
+			ComputationUsed: line.ComputationUsed,
+			MemoryEstimate:  line.MemoryEstimate,
+		}
+
+		txID := line.TxID
+		for capture := range logger.captures {
+			for i := range capture.entries {
+				if capture.entries[i].TransactionID == nil {
+					capture.entries[i].TransactionID = &txID
+				}
+			}
+		}
+	}
+
 	return len(p), nil
 }
 
-func (logger *Interceptor) ClearLogs() {
-	logger.logs = Logs{}
+// capture starts collecting the logs until the capture is stopped.
+func (logger *Interceptor) capture() *logCapture {
+	capture := &logCapture{interceptor: logger}
+
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	logger.captures[capture] = struct{}{}
+
+	return capture
 }
 
-func (logger *Interceptor) GetCadenceLogs() Logs {
-	var filteredLogs Logs
-	for _, log := range logger.logs {
-		if strings.Contains(log, `"message":"Cadence log:`) {
-			filteredLogs = append(filteredLogs, strings.TrimSuffix(log, "\n"))
-		}
+// GetTransactionUsage returns the resources used by the transaction with provided ID,
+// if it's the last executed transaction.
+func (logger *Interceptor) GetTransactionUsage(txID string) model.TransactionUsage {
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+
+	if logger.lastTxID != txID {
+		return model.TransactionUsage{}
 	}
-	return filteredLogs
+
+	return logger.lastUsage
+}
+
+type logCapture struct {
+	interceptor *Interceptor
+	entries     Logs
+}
+
+// stop stops collecting the logs, it's safe to stop the capture more than once.
+func (c *logCapture) stop() {
+	c.interceptor.mutex.Lock()
+	defer c.interceptor.mutex.Unlock()
+	delete(c.interceptor.captures, c)
+}
+
+// collected returns the logs collected so far.
+func (c *logCapture) collected() Logs {
+	c.interceptor.mutex.Lock()
+	defer c.interceptor.mutex.Unlock()
+
+	logs := make(Logs, len(c.entries))
+	copy(logs, c.entries)
+	return logs
 }
@@ -0,0 +1,298 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	userErr "github.com/dapperlabs/flow-playground-api/middleware/errors"
+	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/pkg/errors"
+)
+
+// simpleArgumentTypes are the types converted from plain values by the JSON-Cadence decoder.
+var simpleArgumentTypes = map[string]bool{
+	"String": true, "Character": true, "Address": true,
+	"Int": true, "Int8": true, "Int16": true, "Int32": true, "Int64": true, "Int128": true, "Int256": true,
+	"UInt": true, "UInt8": true, "UInt16": true, "UInt32": true, "UInt64": true, "UInt128": true, "UInt256": true,
+	"Word8": true, "Word16": true, "Word32": true, "Word64": true,
+	"Fix64": true, "UFix64": true,
+}
+
+// ResolveNamedArguments orders the named arguments by the declared parameters of the code entry point.
+//
+// The positional arguments are returned unchanged if there are no named arguments.
+func ResolveNamedArguments(code string, arguments []string, named []*model.NamedArgument) ([]string, error) {
+	if len(named) == 0 {
+		return arguments, nil
+	}
+	if len(arguments) > 0 {
+		return nil, userErr.NewUserError("arguments and named arguments can't be provided together")
+	}
+
+	entry, err := parseEntryPoint(code)
+	if err != nil {
+		return nil, userErr.NewUserError(err.Error())
+	}
+
+	values := make(map[string]string, len(named))
+	for _, argument := range named {
+		values[argument.Name] = argument.Value
+	}
+
+	resolved := make([]string, len(entry.parameters))
+	for i, parameter := range entry.parameters {
+		name := parameter.Identifier.Identifier
+		value, ok := values[name]
+		if !ok {
+			return nil, userErr.NewUserError(fmt.Sprintf("missing argument for parameter %s", name))
+		}
+		resolved[i] = value
+		delete(values, name)
+	}
+
+	for name := range values {
+		return nil, userErr.NewUserError(fmt.Sprintf("the code doesn't declare the parameter %s", name))
+	}
+
+	return resolved, nil
+}
+
+// convertArguments converts the plain argument values to JSON-Cadence using the declared parameter types
+// of the code entry point, the JSON-Cadence arguments are kept as they are.
+//
+// The arguments are returned unchanged if the code can't be parsed, so the errors are reported by the execution.
+func convertArguments(code string, arguments []string) ([]string, error) {
+	plain := false
+	for _, argument := range arguments {
+		if !isJSONCadence(argument) {
+			plain = true
+			break
+		}
+	}
+	if !plain {
+		return arguments, nil
+	}
+
+	entry, err := parseEntryPoint(code)
+	if err != nil {
+		return arguments, nil
+	}
+
+	if len(arguments) < len(entry.parameters) {
+		return nil, userErr.NewUserError(fmt.Sprintf(
+			"missing argument for parameter %s, the code declares %d parameters but %d arguments were provided",
+			entry.parameters[len(arguments)].Identifier.Identifier,
+			len(entry.parameters),
+			len(arguments),
+		))
+	}
+	if len(arguments) > len(entry.parameters) {
+		return nil, userErr.NewUserError(fmt.Sprintf(
+			"too many arguments, the code declares %d parameters but %d arguments were provided",
+			len(entry.parameters),
+			len(arguments),
+		))
+	}
+
+	converted := make([]string, len(arguments))
+	for i, argument := range arguments {
+		if isJSONCadence(argument) {
+			converted[i] = argument
+			continue
+		}
+
+		parameter := entry.parameters[i]
+		value, err := cadenceValueFromPlain(parameter.TypeAnnotation.Type, argument)
+		if err != nil {
+			return nil, userErr.NewUserError(fmt.Sprintf(
+				"invalid argument for parameter %s of type %s: %s",
+				parameter.Identifier.Identifier,
+				parameter.TypeAnnotation.String(),
+				err.Error(),
+			))
+		}
+
+		encoded, err := jsoncdc.Encode(value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode argument")
+		}
+		converted[i] = string(encoded)
+	}
+
+	return converted, nil
+}
+
+func isJSONCadence(argument string) bool {
+	_, err := jsoncdc.Decode(nil, []byte(argument))
+	return err == nil
+}
+
+// cadenceValueFromPlain converts the plain value to a value of the type. Arrays and dictionaries are provided
+// as JSON arrays and objects, and strings are accepted with or without the JSON quotes.
+func cadenceValueFromPlain(valueType ast.Type, value string) (cadence.Value, error) {
+	switch valueType := valueType.(type) {
+	case *ast.OptionalType:
+		trimmed := strings.TrimSpace(value)
+		if trimmed == "nil" || trimmed == "null" || value == "" {
+			return cadence.NewOptional(nil), nil
+		}
+
+		inner, err := cadenceValueFromPlain(valueType.Type, value)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptional(inner), nil
+
+	case *ast.VariableSizedType:
+		return arrayFromPlain(valueType.Type, value)
+
+	case *ast.ConstantSizedType:
+		return arrayFromPlain(valueType.Type, value)
+
+	case *ast.DictionaryType:
+		var entries map[string]json.RawMessage
+		err := json.Unmarshal([]byte(value), &entries)
+		if err != nil {
+			return nil, errors.New("dictionaries must be provided as JSON objects")
+		}
+
+		// the keys are sorted, so the same object is always encoded in the same order
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]cadence.KeyValuePair, 0, len(entries))
+		for _, key := range keys {
+			entry := entries[key]
+			keyValue, err := cadenceValueFromPlain(valueType.KeyType, key)
+			if err != nil {
+				return nil, err
+			}
+
+			entryValue, err := cadenceValueFromPlain(valueType.ValueType, plainFromJSON(entry))
+			if err != nil {
+				return nil, err
+			}
+
+			pairs = append(pairs, cadence.KeyValuePair{Key: keyValue, Value: entryValue})
+		}
+		return cadence.NewDictionary(pairs), nil
+
+	case *ast.NominalType:
+		return simpleValueFromPlain(valueType.String(), value)
+	}
+
+	return nil, errors.New("the type requires a JSON-Cadence value")
+}
+
+func arrayFromPlain(elementType ast.Type, value string) (cadence.Value, error) {
+	var elements []json.RawMessage
+	err := json.Unmarshal([]byte(value), &elements)
+	if err != nil {
+		return nil, errors.New("arrays must be provided as JSON arrays")
+	}
+
+	values := make([]cadence.Value, len(elements))
+	for i, element := range elements {
+		values[i], err = cadenceValueFromPlain(elementType, plainFromJSON(element))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cadence.NewArray(values), nil
+}
+
+func simpleValueFromPlain(typeName string, value string) (cadence.Value, error) {
+	// JSON quotes are optional for all the simple values
+	var unquoted string
+	if err := json.Unmarshal([]byte(value), &unquoted); err == nil {
+		value = unquoted
+	}
+
+	// the surrounding spaces are only ignored for the types which can't contain them
+	if typeName != "String" && typeName != "Character" {
+		value = strings.TrimSpace(value)
+	}
+
+	switch typeName {
+	case "Bool":
+		switch value {
+		case "true":
+			return cadence.NewBool(true), nil
+		case "false":
+			return cadence.NewBool(false), nil
+		}
+		return nil, errors.New("must be true or false")
+
+	case "Path", "StoragePath", "PublicPath", "PrivatePath", "CapabilityPath":
+		parts := strings.SplitN(strings.TrimPrefix(value, "/"), "/", 2)
+		if !strings.HasPrefix(value, "/") || len(parts) != 2 {
+			return nil, errors.New("paths must be in the /domain/identifier format")
+		}
+
+		domain := common.PathDomainFromIdentifier(parts[0])
+		if domain == common.PathDomainUnknown {
+			return nil, fmt.Errorf("unknown path domain %s", parts[0])
+		}
+		return cadence.NewPath(domain, parts[1])
+
+	case "Address":
+		if !strings.HasPrefix(value, "0x") {
+			value = "0x" + value
+		}
+
+	case "Fix64", "UFix64":
+		if !strings.Contains(value, ".") {
+			value += ".0"
+		}
+	}
+
+	if !simpleArgumentTypes[typeName] {
+		return nil, errors.New("the type requires a JSON-Cadence value")
+	}
+
+	encoded, err := json.Marshal(map[string]string{"type": typeName, "value": value})
+	if err != nil {
+		return nil, err
+	}
+
+	return jsoncdc.Decode(nil, encoded)
+}
+
+// plainFromJSON returns the JSON strings without the quotes and the other JSON values as they are.
+func plainFromJSON(value json.RawMessage) string {
+	var str string
+	if err := json.Unmarshal(value, &str); err == nil {
+		return str
+	}
+
+	return string(bytes.TrimSpace(value))
+}
@@ -0,0 +1,54 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+// AvailableBalance returns the FLOW balance of the account not reserved for its storage.
+const AvailableBalance = `
+pub fun main(address: Address): UFix64 {
+	return getAccount(address).availableBalance
+}`
+
+// FundAccount mints FLOW tokens to the recipient, it must be signed by the service account holding the token admin.
+const FundAccount = `
+import FungibleToken from 0x02
+import FlowToken from 0x03
+
+transaction(recipient: Address, amount: UFix64) {
+	let tokenAdmin: &FlowToken.Administrator
+	let tokenReceiver: &{FungibleToken.Receiver}
+
+	prepare(signer: AuthAccount) {
+		self.tokenAdmin = signer.borrow<&FlowToken.Administrator>(from: /storage/flowTokenAdmin)
+			?? panic("signer is not the token admin")
+
+		self.tokenReceiver = getAccount(recipient)
+			.getCapability(/public/flowTokenReceiver)
+			.borrow<&{FungibleToken.Receiver}>()
+			?? panic("unable to borrow the recipient FLOW token receiver")
+	}
+
+	execute {
+		let minter <- self.tokenAdmin.createNewMinter(allowedAmount: amount)
+		let mintedVault <- minter.mintTokens(amount: amount)
+
+		self.tokenReceiver.deposit(from: <-mintedVault)
+
+		destroy minter
+	}
+}`
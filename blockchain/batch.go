@@ -0,0 +1,231 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"fmt"
+
+	userErr "github.com/dapperlabs/flow-playground-api/middleware/errors"
+	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/google/uuid"
+	flowsdk "github.com/onflow/flow-go-sdk"
+	"github.com/pkg/errors"
+)
+
+// ExecuteBatch executes all the batch steps in order as a single operation.
+//
+// The results are persisted only if all the steps succeed, if any of the steps fails
+// the project is left at the block height before the batch was executed.
+func (p *Projects) ExecuteBatch(batch model.NewExecutionBatch) ([]*model.ExecutionBatchResult, error) {
+	projID := batch.ProjectID
+	p.mutex.load(projID).Lock()
+	defer p.mutex.remove(projID).Unlock()
+	fk, err := p.load(projID)
+	if err != nil {
+		return nil, err
+	}
+
+	startHeight, err := fk.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*model.ExecutionBatchResult, len(batch.Steps))
+	deployments := make([]*model.ContractDeployment, 0)
+	executions := make([]*model.TransactionExecution, 0)
+	scripts := make([]*model.ScriptExecution, 0)
+
+	for i, step := range batch.Steps {
+		result, err := p.executeBatchStep(projID, fk, step)
+		if err != nil {
+			// executed steps already changed the emulator state, so it must be recreated from the stored state
+			p.flowKitCache.reset(projID)
+			return nil, errors.Wrap(err, fmt.Sprintf("batch step %d failed", i))
+		}
+
+		if result.ContractDeployment != nil {
+			deployments = append(deployments, result.ContractDeployment)
+		}
+		if result.TransactionExecution != nil {
+			executions = append(executions, result.TransactionExecution)
+		}
+		if result.ScriptExecution != nil {
+			scripts = append(scripts, result.ScriptExecution)
+		}
+
+		results[i] = result
+	}
+
+	err = p.store.InsertExecutionBatch(projID, deployments, executions, scripts)
+	if err != nil {
+		p.flowKitCache.reset(projID)
+		return nil, err
+	}
+
+	endHeight, err := fk.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	// create a snapshot if the batch crossed the snapshot interval
+	initHeight := fk.initBlockHeight()
+	if p.snapshotInterval > 0 && (endHeight-initHeight)/p.snapshotInterval > (startHeight-initHeight)/p.snapshotInterval {
+		p.snapshot(projID, fk, endHeight)
+	}
+
+	return results, nil
+}
+
+// executeBatchStep executes a single batch step on the emulator without persisting the result.
+func (p *Projects) executeBatchStep(
+	projectID uuid.UUID,
+	fk blockchain,
+	step *model.ExecutionBatchStep,
+) (*model.ExecutionBatchResult, error) {
+	switch {
+	case step.ContractDeployment != nil && step.TransactionExecution == nil && step.ScriptExecution == nil:
+		deploy, err := p.executeBatchDeployment(projectID, fk, step.ContractDeployment)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ExecutionBatchResult{ContractDeployment: deploy}, nil
+
+	case step.TransactionExecution != nil && step.ContractDeployment == nil && step.ScriptExecution == nil:
+		exe, err := p.executeBatchTransaction(projectID, fk, step.TransactionExecution)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ExecutionBatchResult{TransactionExecution: exe}, nil
+
+	case step.ScriptExecution != nil && step.ContractDeployment == nil && step.TransactionExecution == nil:
+		exe, err := p.executeBatchScript(projectID, fk, step.ScriptExecution)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ExecutionBatchResult{ScriptExecution: exe}, nil
+
+	default:
+		return nil, userErr.NewUserError(
+			"batch step must contain exactly one of contract deployment, transaction execution or script execution",
+		)
+	}
+}
+
+func (p *Projects) executeBatchDeployment(
+	projectID uuid.UUID,
+	fk blockchain,
+	deployment *model.BatchContractDeployment,
+) (*model.ContractDeployment, error) {
+	contractName, err := parseContractName(deployment.Script)
+	if err != nil {
+		return nil, err
+	}
+
+	flowAccount, err := fk.getAccount(deployment.Address.ToFlowAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	// updating a contract requires truncating the project history, which can't be undone if the batch fails
+	if _, ok := flowAccount.Contracts[contractName]; ok {
+		return nil, userErr.NewUserError(fmt.Sprintf(
+			"contract %s is already deployed to address %s",
+			contractName,
+			deployment.Address.ToFlowAddress().String(),
+		))
+	}
+
+	tx, result, logs, err := fk.deployContract(deployment.Address.ToFlowAddress(), deployment.Script, deployment.Arguments, false)
+	if err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	deploy := model.ContractDeploymentFromFlow(
+		projectID,
+		contractName,
+		deployment.Script,
+		deployment.Arguments,
+		result,
+		tx,
+		logs,
+		int(block.Height),
+		fk.getTransactionUsage(tx.ID()),
+	)
+	deploy.BlockTimestamp = block.Timestamp
+
+	return deploy, nil
+}
+
+func (p *Projects) executeBatchTransaction(
+	projectID uuid.UUID,
+	fk blockchain,
+	execution *model.BatchTransactionExecution,
+) (*model.TransactionExecution, error) {
+	signers := make([]flowsdk.Address, len(execution.Signers))
+	for i, sig := range execution.Signers {
+		signers[i] = sig.ToFlowAddress()
+	}
+
+	tx, result, logs, err := fk.executeTransaction(execution.Script, execution.Arguments, transactionRoles{authorizers: signers})
+	if err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, userErr.NewUserError(result.Error.Error())
+	}
+
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	exe := model.TransactionExecutionFromFlow(
+		projectID,
+		result,
+		tx,
+		logs,
+		int(block.Height),
+		fk.getTransactionUsage(tx.ID()),
+	)
+	exe.Script = execution.Script
+	exe.BlockTimestamp = block.Timestamp
+
+	return exe, nil
+}
+
+func (p *Projects) executeBatchScript(
+	projectID uuid.UUID,
+	fk blockchain,
+	execution *model.BatchScriptExecution,
+) (*model.ScriptExecution, error) {
+	result, logs, err := fk.executeScript(execution.Script, execution.Arguments, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ScriptExecutionFromFlow(result, logs, projectID, execution.Script, execution.Arguments), nil
+}
@@ -19,15 +19,30 @@
 package blockchain
 
 import (
+	"sync"
+
+	"github.com/dapperlabs/flow-playground-api/telemetry"
 	"github.com/golang/groupcache/lru"
 	"github.com/google/uuid"
 )
 
-// newEmulatorCache returns a new instance of cache with provided capacity.
-func newFlowKitCache(capacity int) *flowKitCache {
-	return &flowKitCache{
-		cache: lru.New(capacity),
+// newFlowKitCache returns a new instance of cache bounded by the number of emulators and by their estimated memory,
+// a limit of zero or less disables that bound.
+func newFlowKitCache(capacity int, maxBytes int64) *flowKitCache {
+	c := &flowKitCache{
+		cache:    lru.New(0), // the limits are enforced by the cache, so the evictions can be recorded
+		capacity: capacity,
+		maxBytes: maxBytes,
+		entries:  make(map[uuid.UUID]cacheEntry),
+	}
+
+	c.cache.OnEvicted = func(key lru.Key, _ interface{}) {
+		ID := key.(uuid.UUID)
+		c.bytes -= c.entries[ID].size
+		delete(c.entries, ID)
 	}
+
+	return c
 }
 
 // emulatorCache caches the emulator state.
@@ -38,26 +53,100 @@ func newFlowKitCache(capacity int) *flowKitCache {
 //   - it can be outdated because replica A receives project reset, which clears all executions and the cache, but replica B
 //     doesn't receive that request so on next run it receives 0 executions but cached emulator contains state from previous
 //     executions that wasn't cleared
+//
+// The outdated emulators are detected by the project state version, which is incremented on every reset, rollback
+// or emulator configuration change, and the replicas are notified about it to evict them.
+//
+// The memory of an emulator is estimated by the size of its store, which is measured every time it's added,
+// as the cached emulators keep growing with the executions.
 type flowKitCache struct {
-	cache *lru.Cache
+	mutex    sync.Mutex
+	cache    *lru.Cache
+	capacity int
+	maxBytes int64
+	entries  map[uuid.UUID]cacheEntry
+	bytes    int64
+}
+
+// cacheEntry holds the details of a cached emulator which can be read without affecting the recency.
+type cacheEntry struct {
+	size         int64
+	stateVersion int64
 }
 
 // reset the cache for the ID.
 func (c *flowKitCache) reset(ID uuid.UUID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	c.cache.Remove(ID)
+	telemetry.SetEmulatorCacheBytes(c.bytes)
 }
 
 // get returns a cached emulator if exists, but also checks if it's stale.
 func (c *flowKitCache) get(ID uuid.UUID) *flowKit {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	val, ok := c.cache.Get(ID)
 	if !ok {
+		telemetry.EmulatorCacheMiss()
 		return nil
 	}
 
+	telemetry.EmulatorCacheHit()
 	return val.(*flowKit)
 }
 
-// add new entry in the cache.
+// add new entry in the cache, or update the size of the existing entry, and evict the least recently used
+// entries over the limits. The added entry is always kept, even if it exceeds the memory limit alone.
 func (c *flowKitCache) add(ID uuid.UUID, fk *flowKit) {
+	size := fk.store.estimatedSize()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	c.cache.Add(ID, fk)
+	c.bytes += size - c.entries[ID].size
+	c.entries[ID] = cacheEntry{
+		size:         size,
+		stateVersion: fk.stateVersion,
+	}
+
+	for c.cache.Len() > 1 && c.overLimits() {
+		c.cache.RemoveOldest()
+		telemetry.EmulatorCacheEviction()
+	}
+
+	telemetry.SetEmulatorCacheBytes(c.bytes)
+}
+
+// invalidate removes the cached emulator for the ID if it was built from a state version older than provided.
+func (c *flowKitCache) invalidate(ID uuid.UUID, stateVersion int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[ID]
+	if !ok || entry.stateVersion >= stateVersion {
+		return
+	}
+
+	c.cache.Remove(ID)
+	telemetry.SetEmulatorCacheBytes(c.bytes)
+}
+
+// stateVersions returns the state versions of all the cached emulators.
+func (c *flowKitCache) stateVersions() map[uuid.UUID]int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	versions := make(map[uuid.UUID]int64, len(c.entries))
+	for ID, entry := range c.entries {
+		versions[ID] = entry.stateVersion
+	}
+	return versions
+}
+
+func (c *flowKitCache) overLimits() bool {
+	return (c.capacity > 0 && c.cache.Len() > c.capacity) || (c.maxBytes > 0 && c.bytes > c.maxBytes)
 }
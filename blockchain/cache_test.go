@@ -19,6 +19,7 @@
 package blockchain
 
 import (
+	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,9 +45,9 @@ func Test_Cache(t *testing.T) {
 
 	t.Run("returns cached flowKit", func(t *testing.T) {
 		testID := uuid.New()
-		c := newFlowKitCache(2)
+		c := newFlowKitCache(2, 0)
 
-		em, err := newFlowkit()
+		em, err := newFlowkit(model.DefaultEmulatorConfig())
 		require.NoError(t, err)
 
 		c.add(testID, em)
@@ -63,4 +64,112 @@ func Test_Cache(t *testing.T) {
 		assert.Equal(t, height, cacheHeight)
 	})
 
+	t.Run("evicts least recently used over capacity", func(t *testing.T) {
+		c := newFlowKitCache(2, 0)
+
+		em, err := newFlowkit(model.DefaultEmulatorConfig())
+		require.NoError(t, err)
+
+		first, second, third := uuid.New(), uuid.New(), uuid.New()
+		c.add(first, em)
+		c.add(second, em)
+		require.NotNil(t, c.get(first)) // the second is now the least recently used
+
+		c.add(third, em)
+
+		assert.NotNil(t, c.get(first))
+		assert.Nil(t, c.get(second))
+		assert.NotNil(t, c.get(third))
+		assert.Equal(t, 2*em.store.estimatedSize(), c.bytes)
+	})
+
+	t.Run("evicts least recently used over memory limit", func(t *testing.T) {
+		em, err := newFlowkit(model.DefaultEmulatorConfig())
+		require.NoError(t, err)
+
+		size := em.store.estimatedSize()
+		require.Greater(t, size, int64(0))
+
+		c := newFlowKitCache(0, 2*size)
+
+		first, second, third := uuid.New(), uuid.New(), uuid.New()
+		c.add(first, em)
+		c.add(second, em)
+		c.add(third, em)
+
+		assert.Nil(t, c.get(first))
+		assert.NotNil(t, c.get(second))
+		assert.NotNil(t, c.get(third))
+		assert.Equal(t, 2*size, c.bytes)
+
+		// the emulator growing over the limit alone is still kept
+		c = newFlowKitCache(0, size-1)
+		c.add(first, em)
+		assert.NotNil(t, c.get(first))
+
+		c.reset(first)
+		assert.Nil(t, c.get(first))
+		assert.Equal(t, int64(0), c.bytes)
+	})
+
+	t.Run("tracks emulator growth", func(t *testing.T) {
+		c := newFlowKitCache(0, 0)
+
+		em, err := newFlowkit(model.DefaultEmulatorConfig())
+		require.NoError(t, err)
+
+		ID := uuid.New()
+		c.add(ID, em)
+		size := c.bytes
+
+		_, _, _, err = em.executeTransaction(`transaction { execute { log("grow") } }`, nil, transactionRoles{})
+		require.NoError(t, err)
+
+		c.add(ID, em)
+		assert.Greater(t, c.bytes, size)
+		assert.Equal(t, em.store.estimatedSize(), c.bytes)
+	})
+
+	t.Run("invalidates outdated state versions", func(t *testing.T) {
+		c := newFlowKitCache(0, 0)
+
+		em, err := newFlowkit(model.DefaultEmulatorConfig())
+		require.NoError(t, err)
+		em.stateVersion = 2
+
+		ID := uuid.New()
+		c.add(ID, em)
+		assert.Equal(t, map[uuid.UUID]int64{ID: 2}, c.stateVersions())
+
+		c.invalidate(ID, 2)
+		assert.NotNil(t, c.get(ID))
+
+		c.invalidate(uuid.New(), 3)
+		assert.NotNil(t, c.get(ID))
+
+		c.invalidate(ID, 3)
+		assert.Nil(t, c.get(ID))
+		assert.Empty(t, c.stateVersions())
+		assert.Equal(t, int64(0), c.bytes)
+	})
+
+	t.Run("restored store has the same size", func(t *testing.T) {
+		em, err := newFlowkit(model.DefaultEmulatorConfig())
+		require.NoError(t, err)
+
+		snapshot, err := em.snapshot()
+		require.NoError(t, err)
+
+		store, err := restoreEmulatorStore(snapshot)
+		require.NoError(t, err)
+
+		// only the latest value of every key is restored
+		var latestSize int64
+		for _, keys := range em.store.data {
+			for key, values := range keys {
+				latestSize += int64(len(key)) + values[len(values)-1].size()
+			}
+		}
+		assert.Equal(t, latestSize, store.estimatedSize())
+	})
 }
@@ -0,0 +1,262 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"sort"
+
+	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+	"github.com/onflow/flow-go-sdk"
+)
+
+var validTopLevelDeclarationsInTransaction = common.NewDeclarationKindSet(
+	common.DeclarationKindPragma,
+	common.DeclarationKindImport,
+	common.DeclarationKindFunction,
+	common.DeclarationKindTransaction,
+)
+
+var validTopLevelDeclarationsInContract = common.NewDeclarationKindSet(
+	common.DeclarationKindPragma,
+	common.DeclarationKindImport,
+	common.DeclarationKindContract,
+	common.DeclarationKindContractInterface,
+)
+
+// checkProgram parses and type-checks the code of the kind against the contracts deployed on the emulator,
+// the returned error contains all the parsing or checking errors.
+//
+// String imports are resolved from the provided contract codes by the contract name first, and then from
+// the deployed contracts. The returned checker records the position info, it's nil if the code can't be parsed.
+func (fk *flowKit) checkProgram(code string, kind model.CodeKind, contracts map[string]string) (*sema.Checker, error) {
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	var location common.Location
+	validDeclarations := common.AllDeclarationKindsSet
+	switch kind {
+	case model.CodeKindContract:
+		location = common.StringLocation("contract")
+		validDeclarations = validTopLevelDeclarationsInContract
+	case model.CodeKindTransaction:
+		location = common.TransactionLocation{}
+		validDeclarations = validTopLevelDeclarationsInTransaction
+	default:
+		location = common.ScriptLocation{}
+	}
+
+	standardLibrary := stdlib.DefaultStandardLibraryValues(nil)
+	if kind == model.CodeKindScript {
+		standardLibrary = stdlib.DefaultScriptStandardLibraryValues(nil)
+	}
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	for _, value := range standardLibrary {
+		baseValueActivation.DeclareValue(value)
+	}
+
+	importer := &contractImporter{
+		fk:        fk,
+		contracts: contracts,
+		checkers:  make(map[common.Location]*sema.Checker),
+		checking:  make(map[common.Location]bool),
+	}
+
+	config := &sema.Config{
+		AccessCheckMode: sema.AccessCheckModeStrict,
+		BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+			return baseValueActivation
+		},
+		ValidTopLevelDeclarationsHandler: func(importedLocation common.Location) common.DeclarationKindSet {
+			if importedLocation == location {
+				return validDeclarations
+			}
+			return validTopLevelDeclarationsInContract
+		},
+		LocationHandler:              importer.resolveLocation,
+		ImportHandler:                importer.importContract,
+		AccountLinkingEnabled:        true,
+		AttachmentsEnabled:           true,
+		CapabilityControllersEnabled: true,
+		PositionInfoEnabled:          true,
+	}
+
+	checker, err := sema.NewChecker(program, location, nil, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return checker, checker.Check()
+}
+
+// contractImporter resolves the imports of the checked code to the provided contract codes
+// or the contracts deployed on the emulator.
+type contractImporter struct {
+	fk        *flowKit
+	contracts map[string]string
+	addresses map[string][]flow.Address
+	checkers  map[common.Location]*sema.Checker
+	checking  map[common.Location]bool
+}
+
+// resolveLocation resolves the string imports to the provided contract codes or the accounts
+// the contracts are deployed to, and the address imports to a location for each imported contract.
+func (c *contractImporter) resolveLocation(
+	identifiers []ast.Identifier,
+	location common.Location,
+) ([]sema.ResolvedLocation, error) {
+	if stringLocation, ok := location.(common.StringLocation); ok {
+		if _, ok := c.contracts[stringLocation.String()]; ok {
+			return []sema.ResolvedLocation{{Location: location, Identifiers: identifiers}}, nil
+		}
+
+		if c.addresses == nil {
+			addresses, err := c.fk.contractAddresses()
+			if err != nil {
+				return nil, err
+			}
+			c.addresses = addresses
+		}
+
+		address, err := contractAddress(c.addresses, stringLocation.String(), flow.EmptyAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(identifiers) == 0 {
+			identifiers = []ast.Identifier{{Identifier: stringLocation.String()}}
+		}
+		location = common.AddressLocation{Address: common.Address(address)}
+	}
+
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return []sema.ResolvedLocation{{Location: location, Identifiers: identifiers}}, nil
+	}
+
+	// import all the contracts of the account if no identifiers are specified
+	if len(identifiers) == 0 {
+		account, err := c.fk.getAccount(flow.Address(addressLocation.Address))
+		if err != nil {
+			return nil, err
+		}
+
+		for name := range account.Contracts {
+			identifiers = append(identifiers, ast.Identifier{Identifier: name})
+		}
+		sort.Slice(identifiers, func(i, j int) bool {
+			return identifiers[i].Identifier < identifiers[j].Identifier
+		})
+	}
+
+	resolvedLocations := make([]sema.ResolvedLocation, len(identifiers))
+	for i, identifier := range identifiers {
+		resolvedLocations[i] = sema.ResolvedLocation{
+			Location: common.AddressLocation{
+				Address: addressLocation.Address,
+				Name:    identifier.Identifier,
+			},
+			Identifiers: []ast.Identifier{identifier},
+		}
+	}
+
+	return resolvedLocations, nil
+}
+
+// importContract checks the imported contract code and returns its elaboration.
+func (c *contractImporter) importContract(
+	checker *sema.Checker,
+	importedLocation common.Location,
+	importRange ast.Range,
+) (sema.Import, error) {
+	if importedLocation == stdlib.CryptoCheckerLocation {
+		return sema.ElaborationImport{
+			Elaboration: stdlib.CryptoChecker().Elaboration,
+		}, nil
+	}
+
+	if importedChecker, ok := c.checkers[importedLocation]; ok {
+		return sema.ElaborationImport{
+			Elaboration: importedChecker.Elaboration,
+		}, nil
+	}
+
+	if c.checking[importedLocation] {
+		return nil, &sema.CyclicImportsError{
+			Location: importedLocation,
+			Range:    importRange,
+		}
+	}
+	c.checking[importedLocation] = true
+	defer delete(c.checking, importedLocation)
+
+	code, err := c.contractCode(importedLocation)
+	if err != nil || code == nil {
+		return nil, err
+	}
+
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	importedChecker, err := checker.SubChecker(program, importedLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = importedChecker.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	c.checkers[importedLocation] = importedChecker
+
+	return sema.ElaborationImport{
+		Elaboration: importedChecker.Elaboration,
+	}, nil
+}
+
+// contractCode returns the code of the provided or deployed contract at the location, or nil if there is none.
+func (c *contractImporter) contractCode(location common.Location) ([]byte, error) {
+	switch location := location.(type) {
+	case common.StringLocation:
+		code, ok := c.contracts[location.String()]
+		if !ok {
+			return nil, nil
+		}
+		return []byte(code), nil
+
+	case common.AddressLocation:
+		account, err := c.fk.getAccount(flow.Address(location.Address))
+		if err != nil {
+			return nil, err
+		}
+		return account.Contracts[location.Name], nil
+	}
+
+	return nil, nil
+}
@@ -21,12 +21,15 @@ package blockchain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	userErr "github.com/dapperlabs/flow-playground-api/middleware/errors"
+	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/onflow/cadence"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
 	kit "github.com/onflow/flow-cli/flowkit"
 	"github.com/onflow/flow-cli/flowkit/accounts"
 	"github.com/onflow/flow-cli/flowkit/config"
@@ -34,7 +37,6 @@ import (
 	"github.com/onflow/flow-cli/flowkit/output"
 	"github.com/onflow/flow-cli/flowkit/transactions"
 	emu "github.com/onflow/flow-emulator/emulator"
-	"github.com/onflow/flow-emulator/storage/memstore"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/crypto"
 	"github.com/pkg/errors"
@@ -44,15 +46,16 @@ import (
 // blockchain interface defines an abstract API for communication with the blockchain. It hides complexity from the
 // consumer and communicates using flow native types.
 type blockchain interface {
-	// executeTransaction builds and executes a transaction and uses provided authorizers for signing.
+	// executeTransaction builds and executes a transaction and uses provided transaction roles for signing.
 	executeTransaction(
 		script string,
 		arguments []string,
-		authorizers []flow.Address,
+		roles transactionRoles,
 	) (*flow.Transaction, *flow.TransactionResult, Logs, error)
 
-	// executeScript executes a provided script with the arguments.
-	executeScript(script string, arguments []string) (cadence.Value, Logs, error)
+	// executeScript executes a provided script with the arguments at the block height,
+	// or at the latest block if the block height isn't provided.
+	executeScript(script string, arguments []string, blockHeight *int) (cadence.Value, Logs, error)
 
 	// createAccount creates a new account and returns it along with transaction and result.
 	createAccount() (*flow.Account, error)
@@ -60,28 +63,78 @@ type blockchain interface {
 	// getAccount gets an account by the address
 	getAccount(address flow.Address) (*flow.Account, error)
 
-	// getAccountStorage gets storage for an account by the address
-	getAccountStorage(address flow.Address) (string, error)
+	// getAvailableBalance gets the FLOW balance of an account not reserved for its storage.
+	getAvailableBalance(address flow.Address) (cadence.UFix64, error)
+
+	// fundAccount mints the amount of FLOW tokens to the account.
+	fundAccount(address flow.Address, amount cadence.UFix64) error
+
+	// getAccountStorage gets storage for an account by the address at the block height,
+	// or at the latest block if the block height isn't provided.
+	getAccountStorage(address flow.Address, blockHeight *int) (string, error)
+
+	// getAccountStoragePaths gets the paths of the account storage and the types of their values, without the values.
+	getAccountStoragePaths(address flow.Address) (string, error)
+
+	// getAccountStorageValues gets the account storage values of the provided paths.
+	getAccountStorageValues(address flow.Address, paths []cadence.Path) (string, error)
+
+	// getAccountStorageSizes gets the bytes the values of the provided paths use in the account storage
+	// at the block height, or at the latest block if the block height isn't provided.
+	getAccountStorageSizes(address flow.Address, paths []cadence.Path, blockHeight *int) (map[string]int, error)
 
 	// deployContract deploys a contract on the provided address and returns transaction and result.
+	//
+	// If update is set, an existing contract with the same name is updated in place.
 	deployContract(
 		address flow.Address,
 		script string,
 		arguments []string,
+		update bool,
 	) (*flow.Transaction, *flow.TransactionResult, Logs, error)
 
+	// removeContract removes the contract with provided name from the account.
+	removeContract(address flow.Address, contractName string) error
+
+	// checkProgram parses and type-checks the code against the provided and deployed contracts without executing it.
+	checkProgram(code string, kind model.CodeKind, contracts map[string]string) (*sema.Checker, error)
+
+	// commitBlock commits an empty block.
+	commitBlock() error
+
+	// setBlockTimestamp commits an empty block with the provided timestamp, later blocks continue from it.
+	setBlockTimestamp(timestamp time.Time) error
+
+	// getLatestBlock returns the latest committed block.
+	getLatestBlock() (*flow.Block, error)
+
+	// getTransactionUsage returns resources used by the last executed transaction with provided ID.
+	getTransactionUsage(txID flow.Identifier) model.TransactionUsage
+
 	// getLatestBlock height from the network.
 	getLatestBlockHeight() (int, error)
 
 	initBlockHeight() int
 
-	numAccounts() int
-
 	getFlowJson() (string, error)
+
+	// snapshot serializes the current emulator state so it can be restored later.
+	snapshot() ([]byte, error)
+
+	// historyHeight returns the lowest block height the emulator state can be read at.
+	historyHeight() int
 }
 
 var _ blockchain = &flowKit{}
 
+// transactionRoles defines accounts signing the transaction,
+// the service account is used as the proposer and the payer if they are not provided.
+type transactionRoles struct {
+	proposer    *flow.Address
+	payer       *flow.Address
+	authorizers []flow.Address
+}
+
 // NumEmulatorAccounts 4 accounts with bootstrapped contracts: 0x01, 0x02, 0x03, 0x04
 const NumEmulatorAccounts = 4
 
@@ -90,50 +143,107 @@ const initialAccounts = 5
 
 type flowKit struct {
 	blockchain     *kit.Flowkit
+	gateway        *emulatorGateway
+	store          *emulatorStore
+	config         model.EmulatorConfig
 	logInterceptor *Interceptor
+	// stateVersion is the version of the project state the emulator was built from.
+	stateVersion int64
+	contracts    contractAddressesCache
 }
 
-func newFlowkit() (*flowKit, error) {
+// newFlowkit creates a new emulator with provided configuration and bootstraps the initial accounts and contracts.
+func newFlowkit(emulatorConfig model.EmulatorConfig) (*flowKit, error) {
+	fk, err := newFlowkitWithStore(newEmulatorStore(), emulatorConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fk.bootstrap()
+	if err != nil {
+		return nil, err
+	}
+
+	return fk, nil
+}
+
+// newFlowkitFromSnapshot creates an emulator with the state restored from the provided snapshot.
+func newFlowkitFromSnapshot(snapshot []byte, emulatorConfig model.EmulatorConfig) (*flowKit, error) {
+	store, err := restoreEmulatorStore(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	fk, err := newFlowkitWithStore(store, emulatorConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fk.restore()
+	if err != nil {
+		return nil, err
+	}
+
+	// the clock isn't part of the snapshot, so continue from the latest block if it was moved forward in time
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	if block.Timestamp.After(time.Now()) {
+		fk.gateway.setTimestamp(block.Timestamp)
+	}
+
+	return fk, nil
+}
+
+func newFlowkitWithStore(store *emulatorStore, emulatorConfig model.EmulatorConfig) (*flowKit, error) {
+	emulatorConfig = emulatorConfig.WithDefaults()
+
 	readerWriter := NewInternalReaderWriter()
 	state, err := kit.Init(readerWriter, crypto.ECDSA_P256, crypto.SHA3_256)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create flow-kit state")
 	}
 
+	// sign using the emulator service key so the signatures match the service account keys on the chain
+	serviceKey := emu.DefaultServiceKey()
+	state.SetEmulatorKey(serviceKey.PrivateKey)
+
 	interceptor := NewInterceptor()
 	emulatorLogger := zerolog.New(interceptor)
 
-	emulator := gateway.NewEmulatorGatewayWithOpts(
+	emulator, err := newEmulatorGateway(
 		&gateway.EmulatorKey{
-			PublicKey: emu.DefaultServiceKey().AccountKey().PublicKey,
-			SigAlgo:   emu.DefaultServiceKeySigAlgo,
-			HashAlgo:  emu.DefaultServiceKeyHashAlgo,
+			PublicKey: serviceKey.PrivateKey.PublicKey(),
+			SigAlgo:   serviceKey.SigAlgo,
+			HashAlgo:  serviceKey.HashAlgo,
 		},
-		gateway.WithEmulatorOptions(
-			emu.WithLogger(emulatorLogger),
-			emu.WithStore(memstore.New()),
-			emu.WithTransactionValidationEnabled(false),
-			emu.WithStorageLimitEnabled(false),
-			emu.WithTransactionFeesEnabled(false),
-			emu.WithSimpleAddresses(),
-		),
+		emu.WithLogger(emulatorLogger),
+		emu.WithServerLogger(emulatorLogger),
+		emu.WithStore(store),
+		emu.WithTransactionValidationEnabled(emulatorConfig.TransactionValidationEnabled),
+		emu.WithStorageLimitEnabled(emulatorConfig.StorageLimitEnabled),
+		emu.WithTransactionFeesEnabled(emulatorConfig.TransactionFeesEnabled),
+		emu.WithTransactionMaxGasLimit(uint64(emulatorConfig.ComputationLimit)),
+		emu.WithScriptGasLimit(uint64(emulatorConfig.ComputationLimit)),
+		emu.WithSimpleAddresses(),
+		emu.WithContractRemovalEnabled(true),
 	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create emulator")
+	}
 
-	fk := &flowKit{
+	return &flowKit{
 		blockchain: kit.NewFlowkit(
 			state,
 			config.EmulatorNetwork,
 			emulator,
 			output.NewStdoutLogger(output.NoneLog)),
+		gateway:        emulator,
+		store:          store,
+		config:         emulatorConfig,
 		logInterceptor: interceptor,
-	}
-
-	err = fk.bootstrap()
-	if err != nil {
-		return nil, err
-	}
-
-	return fk, nil
+	}, nil
 }
 
 func (fk *flowKit) bootstrap() error {
@@ -170,6 +280,46 @@ func (fk *flowKit) boostrapAccounts() error {
 	return nil
 }
 
+// restore adds the accounts and contracts already existing on the restored emulator to the flow-kit state.
+func (fk *flowKit) restore() error {
+	err := fk.createServiceAccount()
+	if err != nil {
+		return err
+	}
+
+	err = fk.createEmulatorAccounts()
+	if err != nil {
+		return err
+	}
+
+	for _, address := range accountAddresses(fk) {
+		err = fk.addAccountToState(address.ToFlowAddress())
+		if err != nil {
+			return err
+		}
+	}
+
+	return fk.bootstrapContracts()
+}
+
+// accountAddresses returns addresses of all the user accounts existing on the emulator.
+func accountAddresses(fk blockchain) []model.Address {
+	addresses := make([]model.Address, 0)
+
+	// user accounts are created on sequential addresses, so we add them until we reach one that doesn't exist
+	for i := 0; ; i++ {
+		address := model.NewAddressFromIndex(i)
+		_, err := fk.getAccount(address.ToFlowAddress())
+		if err != nil {
+			break
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
 func (fk *flowKit) bootstrapContracts() error {
 	/* Bootstrapped Emulator Contracts
 	0x01: FlowIDTableStaking, FlowStorageFees, MetadataViews, NonFungibleToken, ViewResolver
@@ -224,8 +374,15 @@ func (fk *flowKit) initBlockHeight() int {
 	return initialAccounts
 }
 
-func (fk *flowKit) numAccounts() int {
-	return initialAccounts
+// snapshot serializes the current emulator state.
+func (fk *flowKit) snapshot() ([]byte, error) {
+	return fk.store.snapshot()
+}
+
+// historyHeight returns the lowest block height the state can be read at,
+// the state before the snapshot the emulator was restored from is not available.
+func (fk *flowKit) historyHeight() int {
+	return int(fk.store.historyHeight)
 }
 
 func (fk *flowKit) getFlowJson() (string, error) {
@@ -251,8 +408,18 @@ func (fk *flowKit) getFlowJson() (string, error) {
 func (fk *flowKit) executeTransaction(
 	script string,
 	arguments []string,
-	authorizers []flow.Address,
+	roles transactionRoles,
 ) (*flow.Transaction, *flow.TransactionResult, Logs, error) {
+	arguments, err := convertArguments(script, arguments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	script, err = fk.resolveImports(script, flow.EmptyAddress)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	tx := &flow.Transaction{}
 	tx.Script = []byte(script)
 
@@ -262,10 +429,15 @@ func (fk *flowKit) executeTransaction(
 	}
 	tx.Arguments = args
 
-	return fk.sendTransaction(tx, authorizers)
+	return fk.sendTransaction(tx, roles)
 }
 
-func (fk *flowKit) executeScript(script string, arguments []string) (cadence.Value, Logs, error) {
+func (fk *flowKit) executeScript(script string, arguments []string, blockHeight *int) (cadence.Value, Logs, error) {
+	arguments, err := convertArguments(script, arguments)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	cadenceArgs := make([]cadence.Value, len(arguments))
 
 	// Encode arguments using a transaction
@@ -284,21 +456,24 @@ func (fk *flowKit) executeScript(script string, arguments []string) (cadence.Val
 		}
 	}
 
-	fk.logInterceptor.ClearLogs()
+	script, err = fk.resolveImports(script, flow.EmptyAddress)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	val, err := fk.blockchain.ExecuteScript(
-		context.Background(),
-		kit.Script{
-			Code:     []byte(script),
-			Args:     cadenceArgs,
-			Location: "",
-		},
-		kit.LatestScriptQuery)
+	var height *uint64
+	if blockHeight != nil {
+		h := uint64(*blockHeight)
+		height = &h
+	}
+
+	// the imports are already resolved, so the script is executed by the gateway to get its own logs
+	val, messages, err := fk.gateway.executeScriptWithLogs([]byte(script), cadenceArgs, height)
 	if err != nil {
 		return nil, nil, userErr.NewUserError(err.Error())
 	}
 
-	logs := fk.logInterceptor.GetCadenceLogs()
+	logs := logsFromMessages(messages)
 
 	return val, logs, nil
 }
@@ -385,17 +560,31 @@ func (fk *flowKit) createAccount() (*flow.Account, error) {
 		return nil, err
 	}
 
-	// Update state with new account
-	state, err := fk.blockchain.State()
+	err = fk.addAccountToState(account.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	return account, nil
+}
+
+// addAccountToState updates the flow-kit state with the new account, so it can be used for signing.
+func (fk *flowKit) addAccountToState(address flow.Address) error {
+	serviceAccount, err := fk.getServiceAccount()
+	if err != nil {
+		return err
+	}
+
+	state, err := fk.blockchain.State()
+	if err != nil {
+		return err
+	}
+
 	name := fmt.Sprintf("Account 0x0%d", len(state.Accounts().Names())-1)
 
 	state.Accounts().AddOrUpdate(&accounts.Account{
 		Name:    name,
-		Address: account.Address,
+		Address: address,
 		Key:     serviceAccount.Key,
 	})
 
@@ -404,12 +593,53 @@ func (fk *flowKit) createAccount() (*flow.Account, error) {
 		Account: name,
 	})
 
-	return account, nil
+	return nil
+}
+
+func (fk *flowKit) getAccountStorage(address flow.Address, blockHeight *int) (string, error) {
+	args := []string{fmt.Sprintf(`{"type":"Address","value":"0x%s"}`, address.Hex())}
+	val, _, err := fk.executeScript(StorageIteration, args, blockHeight)
+	if err != nil {
+		return "", err
+	}
+
+	storage, err := jsoncdc.Encode(val)
+	if err != nil {
+		return "", err
+	}
+	return string(storage), nil
 }
 
-func (fk *flowKit) getAccountStorage(address flow.Address) (string, error) {
+func (fk *flowKit) getAccountStoragePaths(address flow.Address) (string, error) {
 	args := []string{fmt.Sprintf(`{"type":"Address","value":"0x%s"}`, address.Hex())}
-	val, _, err := fk.executeScript(StorageIteration, args)
+	val, _, err := fk.executeScript(StoragePaths, args, nil)
+	if err != nil {
+		return "", err
+	}
+
+	paths, err := jsoncdc.Encode(val)
+	if err != nil {
+		return "", err
+	}
+	return string(paths), nil
+}
+
+func (fk *flowKit) getAccountStorageValues(address flow.Address, paths []cadence.Path) (string, error) {
+	values := make([]cadence.Value, len(paths))
+	for i, path := range paths {
+		values[i] = path
+	}
+
+	encodedPaths, err := jsoncdc.Encode(cadence.NewArray(values))
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{
+		fmt.Sprintf(`{"type":"Address","value":"0x%s"}`, address.Hex()),
+		string(encodedPaths),
+	}
+	val, _, err := fk.executeScript(StorageValues, args, nil)
 	if err != nil {
 		return "", err
 	}
@@ -421,6 +651,69 @@ func (fk *flowKit) getAccountStorage(address flow.Address) (string, error) {
 	return string(storage), nil
 }
 
+func (fk *flowKit) getAccountStorageSizes(
+	address flow.Address,
+	paths []cadence.Path,
+	blockHeight *int,
+) (map[string]int, error) {
+	var height uint64
+	if blockHeight != nil {
+		height = uint64(*blockHeight)
+	} else {
+		latest, err := fk.getLatestBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+		height = uint64(latest)
+	}
+
+	ledger, err := fk.store.LedgerByHeight(context.Background(), height)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountStorageSizes(&storageLedger{snapshot: ledger}, address, paths)
+}
+
+func (fk *flowKit) getAvailableBalance(address flow.Address) (cadence.UFix64, error) {
+	args := []string{fmt.Sprintf(`{"type":"Address","value":"0x%s"}`, address.Hex())}
+	val, _, err := fk.executeScript(AvailableBalance, args, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	balance, ok := val.(cadence.UFix64)
+	if !ok {
+		return 0, fmt.Errorf("available balance must be UFix64, got %s", val.Type().ID())
+	}
+
+	return balance, nil
+}
+
+func (fk *flowKit) fundAccount(address flow.Address, amount cadence.UFix64) error {
+	serviceAccount, err := fk.getServiceAccount()
+	if err != nil {
+		return err
+	}
+
+	args := []string{
+		fmt.Sprintf(`{"type":"Address","value":"0x%s"}`, address.Hex()),
+		fmt.Sprintf(`{"type":"UFix64","value":"%s"}`, amount.String()),
+	}
+
+	_, result, _, err := fk.executeTransaction(FundAccount, args, transactionRoles{
+		authorizers: []flow.Address{serviceAccount.Address},
+	})
+	if err != nil {
+		return err
+	}
+	if result.Error != nil {
+		return userErr.NewUserError(result.Error.Error())
+	}
+
+	return nil
+}
+
 func (fk *flowKit) getAccount(address flow.Address) (*flow.Account, error) {
 	account, err := fk.blockchain.GetAccount(context.Background(), address)
 	if err != nil {
@@ -434,6 +727,7 @@ func (fk *flowKit) deployContract(
 	address flow.Address,
 	script string,
 	arguments []string,
+	update bool,
 ) (*flow.Transaction, *flow.TransactionResult, Logs, error) {
 	state, err := fk.blockchain.State()
 	if err != nil {
@@ -445,12 +739,23 @@ func (fk *flowKit) deployContract(
 		return nil, nil, nil, err
 	}
 
+	arguments, err = convertArguments(script, arguments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	args, err := parseCadenceValues(arguments)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	fk.logInterceptor.ClearLogs()
+	script, err = fk.resolveImports(script, address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	capture := fk.logInterceptor.capture()
+	defer capture.stop()
 
 	txID, _, err := fk.blockchain.AddContract(
 		context.Background(),
@@ -460,9 +765,10 @@ func (fk *flowKit) deployContract(
 			Args:     args,
 			Location: "",
 		},
-		kit.UpdateExistingContract(false),
+		kit.UpdateExistingContract(update),
 	)
-	if err != nil {
+	// a failed transaction is still sealed in a block, so its result is returned instead of the error
+	if err != nil && txID == flow.EmptyID {
 		return nil, nil, nil, err
 	}
 
@@ -472,30 +778,67 @@ func (fk *flowKit) deployContract(
 		true,
 	)
 
-	logs := fk.logInterceptor.GetCadenceLogs()
+	logs := capture.collected()
 
 	return tx, result, logs, err
 }
 
+func (fk *flowKit) removeContract(address flow.Address, contractName string) error {
+	state, err := fk.blockchain.State()
+	if err != nil {
+		return err
+	}
+
+	account, err := state.Accounts().ByAddress(address)
+	if err != nil {
+		return err
+	}
+
+	_, err = fk.blockchain.RemoveContract(context.Background(), account, contractName)
+	return err
+}
+
 func (fk *flowKit) sendTransaction(
 	tx *flow.Transaction,
-	authorizers []flow.Address,
+	roles transactionRoles,
 ) (*flow.Transaction, *flow.TransactionResult, Logs, error) {
 	serviceAccount, err := fk.getServiceAccount()
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
+	state, err := fk.blockchain.State()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	accountByAddress := func(address *flow.Address) (*accounts.Account, error) {
+		if address == nil {
+			return serviceAccount, nil
+		}
+
+		acc, err := state.Accounts().ByAddress(*address)
+		if err != nil {
+			return nil, userErr.NewUserError(fmt.Sprintf("account 0x%s doesn't exist", address.Hex()))
+		}
+		return acc, nil
+	}
+
 	var accountRoles transactions.AccountRoles
-	accountRoles.Payer = *serviceAccount
-	accountRoles.Proposer = *serviceAccount
 
-	state, err := fk.blockchain.State()
+	payer, err := accountByAddress(roles.payer)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	accountRoles.Payer = *payer
+
+	proposer, err := accountByAddress(roles.proposer)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	accountRoles.Proposer = *proposer
 
-	for _, auth := range authorizers {
+	for _, auth := range roles.authorizers {
 		acc, err := state.Accounts().ByAddress(auth)
 		if err != nil {
 			return nil, nil, nil, err
@@ -512,35 +855,95 @@ func (fk *flowKit) sendTransaction(
 		args[i] = arg
 	}
 
-	fk.logInterceptor.ClearLogs()
-
-	tx, result, err := fk.blockchain.SendTransaction(
+	built, err := fk.blockchain.BuildTransaction(
 		context.Background(),
-		accountRoles,
+		accountRoles.AddressRoles(),
+		accountRoles.Proposer.Key.Index(),
 		kit.Script{
 			Code:     tx.Script,
 			Args:     args,
 			Location: "",
 		},
-		tx.GasLimit,
+		uint64(fk.config.ComputationLimit),
 	)
 	if err != nil {
 		return nil, nil, nil, userErr.NewUserError(err.Error())
 	}
 
-	logs := fk.logInterceptor.GetCadenceLogs()
+	// flow-kit signs the envelope before the payload if the proposer is also the payer, which produces
+	// invalid signatures when other accounts authorize the transaction, so the payer must always sign last
+	for _, signer := range transactionSigners(accountRoles) {
+		err = built.SetSigner(signer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		built, err = built.Sign()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	capture := fk.logInterceptor.capture()
+	defer capture.stop()
+
+	tx, result, err := fk.blockchain.SendSignedTransaction(context.Background(), built)
+	if err != nil {
+		return nil, nil, nil, userErr.NewUserError(err.Error())
+	}
+
+	logs := capture.collected()
 
 	return tx, result, logs, nil
 }
 
+// transactionSigners returns unique transaction signers with the payer being the last one.
+func transactionSigners(roles transactions.AccountRoles) []*accounts.Account {
+	signers := make([]*accounts.Account, 0)
+	addIfUnique := func(signer accounts.Account) {
+		if signer.Address == roles.Payer.Address {
+			return
+		}
+		for _, s := range signers {
+			if s.Address == signer.Address {
+				return
+			}
+		}
+		signers = append(signers, &signer)
+	}
+
+	addIfUnique(roles.Proposer)
+	for _, auth := range roles.Authorizers {
+		addIfUnique(auth)
+	}
+
+	return append(signers, &roles.Payer)
+}
+
+func (fk *flowKit) getTransactionUsage(txID flow.Identifier) model.TransactionUsage {
+	return fk.logInterceptor.GetTransactionUsage(txID.String())
+}
+
 func (fk *flowKit) getLatestBlockHeight() (int, error) {
-	block, err := fk.blockchain.Gateway().GetLatestBlock()
+	block, err := fk.getLatestBlock()
 	if err != nil {
 		return 0, err
 	}
 	return int(block.BlockHeader.Height), nil
 }
 
+func (fk *flowKit) getLatestBlock() (*flow.Block, error) {
+	return fk.gateway.GetLatestBlock()
+}
+
+func (fk *flowKit) commitBlock() error {
+	return fk.gateway.commitBlock()
+}
+
+func (fk *flowKit) setBlockTimestamp(timestamp time.Time) error {
+	return fk.gateway.commitBlockAt(timestamp)
+}
+
 func (fk *flowKit) getServiceAccount() (*accounts.Account, error) {
 	state, err := fk.blockchain.State()
 	if err != nil {
@@ -613,6 +1016,6 @@ func parseContractName(code string) (string, error) {
 }
 
 func GetInitialBlockHeightForTesting() int {
-	fk, _ := newFlowkit()
+	fk, _ := newFlowkit(model.DefaultEmulatorConfig())
 	return fk.initBlockHeight()
 }
@@ -20,16 +20,19 @@ package blockchain
 
 import (
 	"context"
+	"sync"
 	"testing"
 
+	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/onflow/flow-cli/flowkit/accounts"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test_NewEmulator tests creating a large number of new accounts and validates corresponding storage addresses
 func Test_NewFlowkit(t *testing.T) {
-	fk, err := newFlowkit()
+	fk, err := newFlowkit(model.DefaultEmulatorConfig())
 	assert.NoError(t, err)
 
 	var accountList []*flow.Account
@@ -62,7 +65,7 @@ func Test_NewFlowkit(t *testing.T) {
 		account, err := fk.getAccount(accountList[i].Address)
 		assert.NoError(t, err)
 
-		accountStorage, err := fk.getAccountStorage(accountList[i].Address)
+		accountStorage, err := fk.getAccountStorage(accountList[i].Address, nil)
 		assert.NoError(t, err)
 
 		assert.Equal(t, account.Address, accountList[i].Address)
@@ -71,7 +74,7 @@ func Test_NewFlowkit(t *testing.T) {
 }
 
 func Test_FlowJsonExport(t *testing.T) {
-	fk, err := newFlowkit()
+	fk, err := newFlowkit(model.DefaultEmulatorConfig())
 	assert.NoError(t, err)
 
 	blockHeight, err := fk.getLatestBlockHeight()
@@ -105,3 +108,314 @@ func Test_FlowJsonExport(t *testing.T) {
 	assert.Contains(t, flowJson, "Service Account")
 	assert.Contains(t, flowJson, "emulator-account")
 }
+
+func Test_ParseProgramSignature(t *testing.T) {
+
+	t.Run("transaction", func(t *testing.T) {
+		signature, err := ParseProgramSignature(`
+			transaction(amount: UFix64, to: Address, tokens: @[AnyResource]?) {
+				prepare(first: AuthAccount, second: AuthAccount) {}
+			}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, model.CodeKindTransaction, signature.Kind)
+		assert.Equal(t, 2, signature.Signers)
+		assert.Nil(t, signature.ReturnType)
+		assert.Equal(t, []*model.ProgramParameter{
+			{Name: "amount", Type: "UFix64"},
+			{Name: "to", Type: "Address"},
+			{Name: "tokens", Type: "@[AnyResource]?"},
+		}, signature.Parameters)
+	})
+
+	t.Run("transaction without prepare", func(t *testing.T) {
+		signature, err := ParseProgramSignature(`transaction { execute {} }`)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, signature.Signers)
+		assert.Empty(t, signature.Parameters)
+	})
+
+	t.Run("script", func(t *testing.T) {
+		signature, err := ParseProgramSignature(`
+			pub fun helper(a: Int): Int { return a }
+			pub fun main(values: {String: Int}): [Int] { return [] }`)
+		require.NoError(t, err)
+
+		assert.Equal(t, model.CodeKindScript, signature.Kind)
+		require.NotNil(t, signature.ReturnType)
+		assert.Equal(t, "[Int]", *signature.ReturnType)
+		assert.Equal(t, []*model.ProgramParameter{{Name: "values", Type: "{String: Int}"}}, signature.Parameters)
+
+		signature, err = ParseProgramSignature(`pub fun main() {}`)
+		require.NoError(t, err)
+		assert.Equal(t, "Void", *signature.ReturnType)
+	})
+
+	t.Run("contract", func(t *testing.T) {
+		signature, err := ParseProgramSignature(`
+			pub contract Foo {
+				init(name: String) {}
+			}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, model.CodeKindContract, signature.Kind)
+		assert.Equal(t, []*model.ProgramParameter{{Name: "name", Type: "String"}}, signature.Parameters)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := ParseProgramSignature(`pub fun helper() {}`)
+		assert.Error(t, err)
+
+		_, err = ParseProgramSignature(`transaction {`)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ConvertArguments(t *testing.T) {
+
+	const script = `
+		pub fun main(
+			amount: UFix64,
+			to: Address,
+			name: String,
+			ok: Bool,
+			path: StoragePath,
+			ids: [UInt64],
+			scores: {String: Int},
+			limit: Int?
+		) {}`
+
+	t.Run("plain values", func(t *testing.T) {
+		arguments, err := convertArguments(script, []string{
+			"1",
+			"01",
+			`"Alice"`,
+			"true",
+			"/storage/vault",
+			"[1, 2]",
+			`{"a": 1}`,
+			"nil",
+		})
+		require.NoError(t, err)
+
+		values, err := parseCadenceValues(arguments)
+		require.NoError(t, err)
+		require.Len(t, values, 8)
+
+		assert.Equal(t, "1.00000000", values[0].String())
+		assert.Equal(t, "0x0000000000000001", values[1].String())
+		assert.Equal(t, `"Alice"`, values[2].String())
+		assert.Equal(t, "true", values[3].String())
+		assert.Equal(t, "/storage/vault", values[4].String())
+		assert.Equal(t, "[1, 2]", values[5].String())
+		assert.Equal(t, `{"a": 1}`, values[6].String())
+		assert.Equal(t, "nil", values[7].String())
+	})
+
+	t.Run("spaces are kept only in strings", func(t *testing.T) {
+		arguments, err := convertArguments(
+			`pub fun main(amount: UFix64, to: Address, ok: Bool, name: String, optional: String?) {}`,
+			[]string{" 1 ", " 0x01\n", " true ", "  Alice ", " Bob "},
+		)
+		require.NoError(t, err)
+
+		values, err := parseCadenceValues(arguments)
+		require.NoError(t, err)
+		require.Len(t, values, 5)
+
+		assert.Equal(t, "1.00000000", values[0].String())
+		assert.Equal(t, "0x0000000000000001", values[1].String())
+		assert.Equal(t, "true", values[2].String())
+		assert.Equal(t, `"  Alice "`, values[3].String())
+		assert.Equal(t, `" Bob "`, values[4].String())
+	})
+
+	t.Run("JSON-Cadence values are kept", func(t *testing.T) {
+		arguments := []string{`{"type":"UFix64","value":"2.5"}`}
+
+		converted, err := convertArguments(`pub fun main(amount: UFix64) {}`, arguments)
+		require.NoError(t, err)
+		assert.Equal(t, arguments, converted)
+	})
+
+	t.Run("invalid value names the parameter", func(t *testing.T) {
+		_, err := convertArguments(`pub fun main(amount: UFix64) {}`, []string{"-1"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "parameter amount of type UFix64")
+	})
+
+	t.Run("argument count mismatch names the parameter", func(t *testing.T) {
+		const tx = `transaction(amount: UFix64, to: Address) {}`
+
+		_, err := convertArguments(tx, []string{"1.5"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing argument for parameter to")
+
+		_, err = convertArguments(tx, []string{"1.5", "0x01", "2"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the code declares 2 parameters but 3 arguments were provided")
+	})
+
+	t.Run("dictionary keys are encoded in order", func(t *testing.T) {
+		const code = `pub fun main(scores: {String: Int}) {}`
+
+		expected, err := convertArguments(code, []string{`{"c": 3, "a": 1, "b": 2}`})
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			converted, err := convertArguments(code, []string{`{"b": 2, "c": 3, "a": 1}`})
+			require.NoError(t, err)
+			assert.Equal(t, expected, converted)
+		}
+	})
+
+	t.Run("named arguments", func(t *testing.T) {
+		const tx = `transaction(amount: UFix64, to: Address) {}`
+
+		arguments, err := ResolveNamedArguments(tx, nil, []*model.NamedArgument{
+			{Name: "to", Value: "0x01"},
+			{Name: "amount", Value: "1.5"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1.5", "0x01"}, arguments)
+
+		_, err = ResolveNamedArguments(tx, nil, []*model.NamedArgument{{Name: "amount", Value: "1.5"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing argument for parameter to")
+
+		_, err = ResolveNamedArguments(tx, nil, []*model.NamedArgument{
+			{Name: "to", Value: "0x01"},
+			{Name: "amount", Value: "1.5"},
+			{Name: "memo", Value: "hi"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "parameter memo")
+	})
+}
+
+func Test_FormatCode(t *testing.T) {
+
+	t.Run("format", func(t *testing.T) {
+		formatted, err := FormatCode(`
+pub contract   Foo {
+  pub var x: Int
+  pub fun add(a: Int,b:Int): Int { return a+b }
+  init() { self.x = 1 }
+}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, `pub contract Foo {
+    pub var x: Int
+
+    pub fun add(a: Int, b: Int): Int {
+        return a + b
+    }
+
+    init() {
+        self.x = 1
+    }
+}
+`, formatted)
+
+		// the formatted code is kept as it is
+		again, err := FormatCode(formatted)
+		require.NoError(t, err)
+		assert.Equal(t, formatted, again)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := FormatCode(`pub fun main( {}`)
+		assert.Error(t, err)
+	})
+
+	t.Run("comments", func(t *testing.T) {
+		formatted, err := FormatCode(`// Greeter greets
+pub contract   Greeter {
+  pub fun hello(): String { return "hi" } // the greeting
+}
+
+pub fun main(): String { return  Greeter.hello() } /* trailing */
+
+/// unused
+pub fun   unused() {}
+// the end`)
+		require.NoError(t, err)
+
+		// the declarations with comments are kept, the others are formatted
+		assert.Equal(t, `// Greeter greets
+pub contract   Greeter {
+  pub fun hello(): String { return "hi" } // the greeting
+}
+
+pub fun main(): String {
+    return Greeter.hello()
+} /* trailing */
+
+/// unused
+pub fun unused() {}
+
+// the end
+`, formatted)
+
+		again, err := FormatCode(formatted)
+		require.NoError(t, err)
+		assert.Equal(t, formatted, again)
+
+		formatted, err = FormatCode("pub fun main(): String { return \"// not a comment\" }")
+		require.NoError(t, err)
+		assert.Equal(t, "pub fun main(): String {\n    return \"// not a comment\"\n}\n", formatted)
+	})
+}
+
+func Test_Interceptor(t *testing.T) {
+
+	t.Run("transaction logs", func(t *testing.T) {
+		interceptor := NewInterceptor()
+
+		// the logs before the capture are not collected
+		_, _ = interceptor.Write([]byte(`{"level":"debug","message":"Cadence log: \"before\""}` + "\n"))
+
+		capture := interceptor.capture()
+		_, _ = interceptor.Write([]byte(`{"level":"debug","message":"Cadence log: \"hello\""}` + "\n"))
+		_, _ = interceptor.Write([]byte(`{"level":"info","message":"not a Cadence log"}` + "\n"))
+		_, _ = interceptor.Write([]byte(`{"level":"debug","message":"Cadence log: 42"}` + "\n"))
+		_, _ = interceptor.Write([]byte(
+			`{"level":"debug","txID":"abc","computationUsed":12,"memoryEstimate":34,"message":"Transaction executed"}` + "\n",
+		))
+		capture.stop()
+
+		_, _ = interceptor.Write([]byte(`{"level":"debug","message":"Cadence log: \"after\""}` + "\n"))
+
+		txID := "abc"
+		assert.Equal(t, Logs{
+			{Level: "debug", Message: `"hello"`, Line: 1, TransactionID: &txID},
+			{Level: "debug", Message: "42", Line: 2, TransactionID: &txID},
+		}, capture.collected())
+
+		assert.Equal(t, model.TransactionUsage{ComputationUsed: 12, MemoryEstimate: 34}, interceptor.GetTransactionUsage("abc"))
+		assert.Equal(t, model.TransactionUsage{}, interceptor.GetTransactionUsage("def"))
+	})
+
+	t.Run("concurrent writes", func(t *testing.T) {
+		interceptor := NewInterceptor()
+		capture := interceptor.capture()
+		defer capture.stop()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = interceptor.Write([]byte(`{"level":"debug","message":"Cadence log: 1"}` + "\n"))
+			}()
+		}
+		wg.Wait()
+
+		logs := capture.collected()
+		require.Len(t, logs, 100)
+		for i, entry := range logs {
+			assert.Equal(t, i+1, entry.Line)
+		}
+	})
+}
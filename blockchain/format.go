@@ -0,0 +1,168 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/parser/lexer"
+)
+
+// FormatCode parses the code and prints it canonically formatted.
+//
+// The comments are not part of the parsed program, so the declarations which contain comments are kept
+// as they are, and the comments between the declarations are kept before the following declaration,
+// or after the previous one if they are on the same line.
+func FormatCode(code string) (string, error) {
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	if err != nil {
+		return "", err
+	}
+
+	comments := commentRanges(code)
+
+	var builder strings.Builder
+	previousEnd := 0
+	for i, declaration := range program.Declarations() {
+		start := declaration.StartPosition().Offset
+		end := declaration.EndPosition(nil).Offset + 1
+
+		trailing, leading := gapComments(code, comments, previousEnd, start)
+		if trailing != "" {
+			builder.WriteString(" " + trailing)
+		}
+		if i > 0 {
+			builder.WriteString("\n\n")
+		}
+		if leading != "" {
+			builder.WriteString(leading + "\n")
+		}
+
+		if hasCommentIn(comments, start, end) {
+			builder.WriteString(code[start:end])
+		} else {
+			builder.WriteString(ast.Prettier(declaration))
+		}
+
+		previousEnd = end
+	}
+
+	trailing, leading := gapComments(code, comments, previousEnd, len(code))
+	if trailing != "" {
+		builder.WriteString(" " + trailing)
+	}
+	if leading != "" {
+		if builder.Len() > 0 {
+			builder.WriteString("\n\n")
+		}
+		builder.WriteString(leading)
+	}
+
+	lines := strings.Split(builder.String(), "\n")
+	for i, line := range lines {
+		// the blank lines between the declarations are indented by the printer
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// commentRange is the byte range of a comment, nested block comments are part of the outermost comment.
+type commentRange struct {
+	start, end int
+}
+
+func commentRanges(code string) []commentRange {
+	tokens := lexer.Lex([]byte(code), nil)
+	defer tokens.Reclaim()
+
+	var comments []commentRange
+	depth := 0
+	for {
+		token := tokens.Next()
+		switch token.Type {
+		case lexer.TokenEOF:
+			return comments
+
+		case lexer.TokenLineComment:
+			comments = append(comments, commentRange{
+				start: token.StartPos.Offset,
+				end:   token.EndPos.Offset + 1,
+			})
+
+		case lexer.TokenBlockCommentStart:
+			if depth == 0 {
+				comments = append(comments, commentRange{start: token.StartPos.Offset})
+			}
+			depth++
+
+		case lexer.TokenBlockCommentEnd:
+			depth--
+			if depth == 0 {
+				comments[len(comments)-1].end = token.EndPos.Offset + 1
+			}
+		}
+	}
+}
+
+func hasCommentIn(comments []commentRange, start, end int) bool {
+	for _, comment := range comments {
+		if comment.start >= start && comment.start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// gapComments returns the comments between the declarations, split into the comments on the line
+// the previous declaration ends and the comments on the following lines.
+func gapComments(code string, comments []commentRange, start, end int) (trailing string, leading string) {
+	trailingStart, trailingEnd := -1, -1
+	leadingStart, leadingEnd := -1, -1
+
+	for _, comment := range comments {
+		if comment.start < start || comment.start >= end {
+			continue
+		}
+
+		// the comments at the beginning of the code are never trailing
+		if start > 0 && !strings.Contains(code[start:comment.start], "\n") {
+			if trailingStart < 0 {
+				trailingStart = comment.start
+			}
+			trailingEnd = comment.end
+			continue
+		}
+
+		if leadingStart < 0 {
+			leadingStart = comment.start
+		}
+		leadingEnd = comment.end
+	}
+
+	if trailingStart >= 0 {
+		trailing = code[trailingStart:trailingEnd]
+	}
+	if leadingStart >= 0 {
+		leading = code[leadingStart:leadingEnd]
+	}
+	return trailing, leading
+}
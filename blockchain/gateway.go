@@ -0,0 +1,369 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-cli/flowkit/gateway"
+	"github.com/onflow/flow-emulator/adapters"
+	emu "github.com/onflow/flow-emulator/emulator"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+var _ gateway.Gateway = &emulatorGateway{}
+
+// emulatorGateway is a flow-kit gateway to the emulator.
+//
+// Opposed to the flow-kit emulator gateway, it exposes the emulator so we can commit empty blocks
+// and control the block timestamps.
+type emulatorGateway struct {
+	emulator *emu.Blockchain
+	adapter  *adapters.SDKAdapter
+	clock    *blockClock
+}
+
+// newEmulatorGateway creates a new emulator with the provided options and a gateway to it.
+func newEmulatorGateway(key *gateway.EmulatorKey, options ...emu.Option) (*emulatorGateway, error) {
+	options = append(
+		[]emu.Option{emu.WithServicePublicKey(key.PublicKey, key.SigAlgo, key.HashAlgo)},
+		options...,
+	)
+
+	emulator, err := emu.New(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	clock := &blockClock{}
+	emulator.SetClock(clock)
+	emulator.EnableAutoMine()
+
+	logger := zerolog.Nop()
+
+	return &emulatorGateway{
+		emulator: emulator,
+		adapter:  adapters.NewSDKAdapter(&logger, emulator),
+		clock:    clock,
+	}, nil
+}
+
+// commitBlock commits the pending block without any transactions.
+func (g *emulatorGateway) commitBlock() error {
+	_, err := g.emulator.CommitBlock()
+	return err
+}
+
+// commitBlockAt commits the pending block without any transactions with the provided timestamp,
+// the timestamps of the next blocks continue from it.
+func (g *emulatorGateway) commitBlockAt(timestamp time.Time) error {
+	// the pending block timestamp is taken from the clock only when the clock is set
+	g.emulator.SetClock(fixedClock(timestamp))
+	_, err := g.emulator.CommitBlock()
+	if err != nil {
+		return err
+	}
+
+	g.setTimestamp(timestamp)
+	return nil
+}
+
+// setTimestamp moves the clock used for timestamps of the pending and the next blocks.
+func (g *emulatorGateway) setTimestamp(timestamp time.Time) {
+	g.clock.set(timestamp)
+	g.emulator.SetClock(g.clock)
+}
+
+// setPendingTimestamp sets the timestamp of the pending block to replay it at the recorded time,
+// a zero timestamp leaves the pending block timestamp to the clock.
+func (g *emulatorGateway) setPendingTimestamp(timestamp time.Time) {
+	if timestamp.IsZero() {
+		g.emulator.SetClock(g.clock)
+		return
+	}
+	g.emulator.SetClock(fixedClock(timestamp))
+}
+
+// resumeClock continues the timestamps of the next blocks from the system time shifted by the offset,
+// but never earlier than the latest block timestamp.
+func (g *emulatorGateway) resumeClock(offset time.Duration) error {
+	block, err := g.GetLatestBlock()
+	if err != nil {
+		return err
+	}
+
+	g.clock.setOffset(offset)
+	if g.clock.Now().Before(block.Timestamp) {
+		g.clock.set(block.Timestamp)
+	}
+	g.emulator.SetClock(g.clock)
+	return nil
+}
+
+func (g *emulatorGateway) GetAccount(address flow.Address) (*flow.Account, error) {
+	account, err := g.adapter.GetAccount(context.Background(), address)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return account, nil
+}
+
+func (g *emulatorGateway) SendSignedTransaction(tx *flow.Transaction) (*flow.Transaction, error) {
+	err := g.adapter.SendTransaction(context.Background(), *tx)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return tx, nil
+}
+
+func (g *emulatorGateway) GetTransaction(id flow.Identifier) (*flow.Transaction, error) {
+	tx, err := g.adapter.GetTransaction(context.Background(), id)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return tx, nil
+}
+
+func (g *emulatorGateway) GetTransactionResultsByBlockID(id flow.Identifier) ([]*flow.TransactionResult, error) {
+	results, err := g.adapter.GetTransactionResultsByBlockID(context.Background(), id)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return results, nil
+}
+
+func (g *emulatorGateway) GetTransactionResult(id flow.Identifier, _ bool) (*flow.TransactionResult, error) {
+	result, err := g.adapter.GetTransactionResult(context.Background(), id)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return result, nil
+}
+
+func (g *emulatorGateway) GetTransactionsByBlockID(id flow.Identifier) ([]*flow.Transaction, error) {
+	txs, err := g.adapter.GetTransactionsByBlockID(context.Background(), id)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return txs, nil
+}
+
+func (g *emulatorGateway) ExecuteScript(script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	args, err := encodeCadenceValues(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := g.adapter.ExecuteScriptAtLatestBlock(context.Background(), script, args)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+
+	return jsoncdc.Decode(nil, result)
+}
+
+func (g *emulatorGateway) ExecuteScriptAtHeight(
+	script []byte,
+	arguments []cadence.Value,
+	height uint64,
+) (cadence.Value, error) {
+	args, err := encodeCadenceValues(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := g.adapter.ExecuteScriptAtBlockHeight(context.Background(), height, script, args)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+
+	return jsoncdc.Decode(nil, result)
+}
+
+func (g *emulatorGateway) ExecuteScriptAtID(
+	script []byte,
+	arguments []cadence.Value,
+	id flow.Identifier,
+) (cadence.Value, error) {
+	args, err := encodeCadenceValues(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := g.adapter.ExecuteScriptAtBlockID(context.Background(), id, script, args)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+
+	return jsoncdc.Decode(nil, result)
+}
+
+// executeScriptWithLogs executes the script at the latest block, or at the provided block height,
+// and returns the value together with the messages the script logged.
+//
+// The logs are taken from the script result, as the scripts are executed concurrently.
+func (g *emulatorGateway) executeScriptWithLogs(
+	script []byte,
+	arguments []cadence.Value,
+	height *uint64,
+) (cadence.Value, []string, error) {
+	args, err := encodeCadenceValues(arguments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result *types.ScriptResult
+	if height != nil {
+		result, err = g.emulator.ExecuteScriptAtBlockHeight(script, args, *height)
+	} else {
+		result, err = g.emulator.ExecuteScript(script, args)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !result.Succeeded() {
+		return nil, nil, result.Error
+	}
+
+	return result.Value, result.Logs, nil
+}
+
+func (g *emulatorGateway) GetLatestBlock() (*flow.Block, error) {
+	block, _, err := g.adapter.GetLatestBlock(context.Background(), true)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return block, nil
+}
+
+func (g *emulatorGateway) GetBlockByHeight(height uint64) (*flow.Block, error) {
+	block, _, err := g.adapter.GetBlockByHeight(context.Background(), height)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return block, nil
+}
+
+func (g *emulatorGateway) GetBlockByID(id flow.Identifier) (*flow.Block, error) {
+	block, _, err := g.adapter.GetBlockByID(context.Background(), id)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return block, nil
+}
+
+func (g *emulatorGateway) GetEvents(eventType string, startHeight uint64, endHeight uint64) ([]flow.BlockEvents, error) {
+	blockEvents, err := g.adapter.GetEventsForHeightRange(context.Background(), eventType, startHeight, endHeight)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+
+	events := make([]flow.BlockEvents, len(blockEvents))
+	for i, e := range blockEvents {
+		events[i] = *e
+	}
+
+	return events, nil
+}
+
+func (g *emulatorGateway) GetCollection(id flow.Identifier) (*flow.Collection, error) {
+	collection, err := g.adapter.GetCollectionByID(context.Background(), id)
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return collection, nil
+}
+
+func (g *emulatorGateway) GetLatestProtocolStateSnapshot() ([]byte, error) {
+	snapshot, err := g.adapter.GetLatestProtocolStateSnapshot(context.Background())
+	if err != nil {
+		return nil, gateway.UnwrapStatusError(err)
+	}
+	return snapshot, nil
+}
+
+func (g *emulatorGateway) Ping() error {
+	err := g.adapter.Ping(context.Background())
+	if err != nil {
+		return gateway.UnwrapStatusError(err)
+	}
+	return nil
+}
+
+func (g *emulatorGateway) SecureConnection() bool {
+	return false
+}
+
+func encodeCadenceValues(values []cadence.Value) ([][]byte, error) {
+	args := make([][]byte, len(values))
+	for i, value := range values {
+		encoded, err := jsoncdc.Encode(value)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to encode argument %d", i))
+		}
+		args[i] = encoded
+	}
+
+	return args, nil
+}
+
+// timestampPrecision is the precision of the stored block timestamps, the block timestamps are truncated to it
+// so the blocks are replayed with the same timestamps.
+const timestampPrecision = time.Microsecond
+
+// blockClock is the emulator clock shifted from the system time by the offset, so the block timestamps
+// can be moved forward or backward in time while still progressing between blocks.
+type blockClock struct {
+	mutex  sync.RWMutex
+	offset time.Duration
+}
+
+func (c *blockClock) Now() time.Time {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return time.Now().Add(c.offset).UTC().Truncate(timestampPrecision)
+}
+
+func (c *blockClock) set(timestamp time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.offset = time.Until(timestamp)
+}
+
+func (c *blockClock) setOffset(offset time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.offset = offset
+}
+
+// fixedClock is the emulator clock always returning the same time.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c).UTC().Truncate(timestampPrecision)
+}
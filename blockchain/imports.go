@@ -0,0 +1,176 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	userErr "github.com/dapperlabs/flow-playground-api/middleware/errors"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// resolveImports replaces the string imports, like `import "Foo"` or `import Foo from "Foo"`, with address imports
+// of the contracts deployed to the project accounts or bootstrapped on the emulator accounts.
+//
+// The imports of the contracts deployed to multiple accounts are resolved to the preferred account,
+// if the contract is deployed to it.
+//
+// The code is returned unchanged if it can't be parsed, so the parsing errors are reported by the execution.
+func (fk *flowKit) resolveImports(code string, preferred flow.Address) (string, error) {
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	if err != nil {
+		return code, nil
+	}
+
+	imports := make([]*ast.ImportDeclaration, 0)
+	for _, declaration := range program.ImportDeclarations() {
+		if _, ok := declaration.Location.(common.StringLocation); ok {
+			imports = append(imports, declaration)
+		}
+	}
+	if len(imports) == 0 {
+		return code, nil
+	}
+
+	addresses, err := fk.contractAddresses()
+	if err != nil {
+		return "", err
+	}
+
+	// replace from the end, so the offsets of the preceding imports remain valid
+	sort.Slice(imports, func(i, j int) bool {
+		return imports[i].StartPos.Offset > imports[j].StartPos.Offset
+	})
+
+	for _, declaration := range imports {
+		name := declaration.Location.(common.StringLocation).String()
+
+		address, err := contractAddress(addresses, name, preferred)
+		if err != nil {
+			return "", err
+		}
+
+		identifiers := []string{name}
+		if len(declaration.Identifiers) > 0 {
+			identifiers = make([]string, len(declaration.Identifiers))
+			for i, identifier := range declaration.Identifiers {
+				identifiers[i] = identifier.Identifier
+			}
+		}
+
+		replacement := fmt.Sprintf(
+			"import %s from 0x%s",
+			strings.Join(identifiers, ", "),
+			address.Hex(),
+		)
+		code = code[:declaration.StartPos.Offset] + replacement + code[declaration.EndPos.Offset+1:]
+	}
+
+	return code, nil
+}
+
+// contractAddress returns the address of the only account the contract with the name is deployed to,
+// or the preferred address if the contract is deployed to multiple accounts including it.
+func contractAddress(addresses map[string][]flow.Address, name string, preferred flow.Address) (flow.Address, error) {
+	contractAddresses := addresses[name]
+	for _, address := range contractAddresses {
+		if address == preferred {
+			return address, nil
+		}
+	}
+
+	if len(contractAddresses) == 0 {
+		return flow.EmptyAddress, userErr.NewUserError(
+			fmt.Sprintf("import %s could not be resolved from the project contracts", name),
+		)
+	}
+	if len(contractAddresses) > 1 {
+		return flow.EmptyAddress, userErr.NewUserError(fmt.Sprintf(
+			"import %s is ambiguous, the contract is deployed to multiple accounts, import it by the address instead",
+			name,
+		))
+	}
+
+	return contractAddresses[0], nil
+}
+
+// contractAddressesCache holds the contract addresses resolved at the block,
+// the deployed contracts only change when a new block is committed.
+type contractAddressesCache struct {
+	mutex     sync.Mutex
+	blockID   flow.Identifier
+	addresses map[string][]flow.Address
+}
+
+// contractAddresses returns the addresses of the accounts by the names of the contracts deployed to them.
+//
+// The returned map is shared until the next block, so it must not be modified.
+func (fk *flowKit) contractAddresses() (map[string][]flow.Address, error) {
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	fk.contracts.mutex.Lock()
+	defer fk.contracts.mutex.Unlock()
+
+	if fk.contracts.addresses != nil && fk.contracts.blockID == block.ID {
+		return fk.contracts.addresses, nil
+	}
+
+	addresses, err := fk.deployedContractAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	fk.contracts.blockID = block.ID
+	fk.contracts.addresses = addresses
+	return addresses, nil
+}
+
+// deployedContractAddresses reads the contracts of the emulator and project accounts.
+func (fk *flowKit) deployedContractAddresses() (map[string][]flow.Address, error) {
+	addresses := make([]flow.Address, 0)
+	for i := 1; i <= NumEmulatorAccounts; i++ {
+		addresses = append(addresses, flow.HexToAddress(fmt.Sprintf("0x0%d", i)))
+	}
+	for _, address := range accountAddresses(fk) {
+		addresses = append(addresses, address.ToFlowAddress())
+	}
+
+	contracts := make(map[string][]flow.Address)
+	for _, address := range addresses {
+		account, err := fk.getAccount(address)
+		if err != nil {
+			return nil, err
+		}
+
+		for name := range account.Contracts {
+			contracts[name] = append(contracts[name], address)
+		}
+	}
+
+	return contracts, nil
+}
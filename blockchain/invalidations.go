@@ -0,0 +1,108 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/dapperlabs/flow-playground-api/storage"
+	"github.com/getsentry/sentry-go"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// WatchStateInvalidations evicts the cached emulators of the projects reset or rolled back on other replicas,
+// until the context is done. The invalidations are received as database notifications if the database supports
+// them, otherwise the state versions of the cached projects are polled on every interval.
+//
+// The state version is also checked every time a project is loaded, so a missed invalidation only delays
+// releasing the memory of an outdated emulator.
+func (p *Projects) WatchStateInvalidations(ctx context.Context, pollInterval time.Duration) {
+	for {
+		err := p.store.ListenStateInvalidations(ctx, p.flowKitCache.invalidate)
+		if errors.Is(err, storage.ErrNotSupported) {
+			p.pollStateVersions(ctx, pollInterval)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		sentry.CaptureException(errors.Wrap(err, "failed to listen to state invalidations"))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+
+		// invalidations might have been missed while the listener was disconnected
+		p.checkStateVersions()
+	}
+}
+
+// pollStateVersions checks the state versions of the cached projects on every interval until the context is done.
+func (p *Projects) pollStateVersions(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkStateVersions()
+		}
+	}
+}
+
+// checkStateVersions evicts the cached emulators built from an outdated state or of deleted projects.
+func (p *Projects) checkStateVersions() {
+	cached := p.flowKitCache.stateVersions()
+	if len(cached) == 0 {
+		return
+	}
+
+	IDs := make([]uuid.UUID, 0, len(cached))
+	for ID := range cached {
+		IDs = append(IDs, ID)
+	}
+
+	versions := make(map[uuid.UUID]int64, len(IDs))
+	err := p.store.GetProjectStateVersions(IDs, versions)
+	if err != nil {
+		sentry.CaptureException(errors.Wrap(err, "failed to get project state versions"))
+		return
+	}
+
+	for _, ID := range IDs {
+		version, ok := versions[ID]
+		if !ok { // project was deleted
+			p.flowKitCache.reset(ID)
+			continue
+		}
+
+		p.flowKitCache.invalidate(ID, version)
+	}
+}
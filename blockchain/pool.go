@@ -19,16 +19,71 @@
 package blockchain
 
 import (
+	"sync"
+
+	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/getsentry/sentry-go"
+	"github.com/golang/groupcache/lru"
 	"github.com/pkg/errors"
 )
 
 // todo possible improvement is to also create default accounts as part of bootstrap
 
-// newFlowKitPool creates new instance of instance pool with provided size.
-func newFlowKitPool(size int) *flowKitPool {
-	pool := &flowKitPool{
+const (
+	// configPoolSize is the number of prepared emulators with a configuration other than the default one.
+	configPoolSize = 2
+	// maxConfigPools is the number of the other configurations for which the emulators are prepared.
+	maxConfigPools = 8
+)
+
+// newFlowKitPool creates new instance of instance pool with provided size and default emulator configuration.
+func newFlowKitPool(size int, config model.EmulatorConfig) *flowKitPool {
+	return &flowKitPool{
+		defaultPool: newInstancePool(size, config),
+		configPools: lru.New(maxConfigPools),
+	}
+}
+
+// flowKitPool is an instance pool that optimize slow init time of emulators and hence prepare them upfront.
+//
+// This is an optimization trick to avoid waiting for slow bootstrap time of a new emulator once it's needed,
+// we instead prepare bootstrapped emulators ahead of time.
+//
+// Most projects use the default configuration, so it has the largest pool. The configuration is chosen freely
+// for each project, so only small pools for the recently used other configurations are kept,
+// as keeping prepared instances for all of them would grow the memory without limit.
+type flowKitPool struct {
+	defaultPool *instancePool
+	mutex       sync.Mutex
+	configPools *lru.Cache // configuration -> *instancePool
+}
+
+// new returns a new emulator instance with provided configuration, taken from the pool of that configuration.
+func (p *flowKitPool) new(config model.EmulatorConfig) (*flowKit, error) {
+	config = config.WithDefaults()
+	if config == p.defaultPool.config {
+		return p.defaultPool.new()
+	}
+
+	p.mutex.Lock()
+	cached, ok := p.configPools.Get(config)
+	if !ok {
+		p.configPools.Add(config, newInstancePool(configPoolSize, config))
+	}
+	p.mutex.Unlock()
+
+	if !ok { // the new pool is still being prepared
+		return newFlowkit(config)
+	}
+
+	return cached.(*instancePool).new()
+}
+
+// newInstancePool creates a pool of emulators with the configuration and starts preparing them.
+func newInstancePool(size int, config model.EmulatorConfig) *instancePool {
+	pool := &instancePool{
 		instances: make(chan *flowKit, size),
+		config:    config.WithDefaults(),
 	}
 
 	for i := 0; i < size; i++ {
@@ -38,28 +93,26 @@ func newFlowKitPool(size int) *flowKitPool {
 	return pool
 }
 
-// flowKitPool is an instance pool that optimize slow init time of emulators and hence prepare them upfront.
-//
-// This is an optimization trick to avoid waiting for slow bootstrap time of a new emulator once it's needed,
-// we instead prepare bootstrapped emulators ahead of time.
-type flowKitPool struct {
+// instancePool keeps prepared emulators with a single configuration.
+type instancePool struct {
 	instances chan *flowKit
+	config    model.EmulatorConfig
 }
 
-// new returns a new emulator instance from the instance pool.
-func (p *flowKitPool) new() (*flowKit, error) {
+// new returns a prepared emulator instance and starts preparing another one.
+func (p *instancePool) new() (*flowKit, error) {
 	select {
 	case em := <-p.instances:
 		go p.create()
 		return em, nil
 	default: // in case pool gets emptied
 		sentry.CaptureMessage("instance pool empty")
-		return newFlowkit()
+		return newFlowkit(p.config)
 	}
 }
 
 // add an emulator to internal instance pool, only to be used internally.
-func (p *flowKitPool) add(fk *flowKit) {
+func (p *instancePool) add(fk *flowKit) {
 	select {
 	case p.instances <- fk:
 	default:
@@ -69,8 +122,8 @@ func (p *flowKitPool) add(fk *flowKit) {
 }
 
 // create a new emulator for internal instance pool, only to be used internally.
-func (p *flowKitPool) create() {
-	em, err := newFlowkit()
+func (p *instancePool) create() {
+	em, err := newFlowkit(p.config)
 	if err != nil {
 		sentry.CaptureException(errors.Wrap(err, "instance pool emulator creation failure"))
 		return
@@ -19,17 +19,19 @@
 package blockchain
 
 import (
+	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"sync"
 	"testing"
+	"time"
 )
 
 func Test_InstancePool(t *testing.T) {
 
 	t.Run("get single instance", func(t *testing.T) {
-		pool := newFlowKitPool(2)
-		fk, err := pool.new()
+		pool := newFlowKitPool(2, model.DefaultEmulatorConfig())
+		fk, err := pool.new(model.DefaultEmulatorConfig())
 		require.NoError(t, err)
 		h, err := fk.getLatestBlockHeight()
 		require.NoError(t, err)
@@ -37,10 +39,10 @@ func Test_InstancePool(t *testing.T) {
 	})
 
 	t.Run("drain out the pool", func(t *testing.T) {
-		pool := newFlowKitPool(3)
+		pool := newFlowKitPool(3, model.DefaultEmulatorConfig())
 
 		for i := 0; i < 5; i++ {
-			fk, err := pool.new()
+			fk, err := pool.new(model.DefaultEmulatorConfig())
 			require.NoError(t, err)
 			h, err := fk.getLatestBlockHeight()
 			require.NoError(t, err)
@@ -49,13 +51,13 @@ func Test_InstancePool(t *testing.T) {
 	})
 
 	t.Run("concurrently access pool", func(t *testing.T) {
-		pool := newFlowKitPool(5)
+		pool := newFlowKitPool(5, model.DefaultEmulatorConfig())
 
 		var wg sync.WaitGroup
 		for i := 0; i < 5; i++ {
 			wg.Add(1)
 			go func() {
-				fk, err := pool.new()
+				fk, err := pool.new(model.DefaultEmulatorConfig())
 				require.NoError(t, err)
 				h, err := fk.getLatestBlockHeight()
 				require.NoError(t, err)
@@ -66,4 +68,37 @@ func Test_InstancePool(t *testing.T) {
 
 		wg.Wait()
 	})
+
+	t.Run("create instance with other configuration", func(t *testing.T) {
+		pool := newFlowKitPool(1, model.DefaultEmulatorConfig())
+
+		config := model.EmulatorConfig{ComputationLimit: 9999}
+		fk, err := pool.new(config)
+		require.NoError(t, err)
+		assert.Equal(t, config, fk.config)
+
+		fk, err = pool.new(model.EmulatorConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, model.DefaultEmulatorConfig(), fk.config)
+	})
+
+	t.Run("prepare instances with other configuration", func(t *testing.T) {
+		pool := newFlowKitPool(1, model.DefaultEmulatorConfig())
+
+		config := model.EmulatorConfig{ComputationLimit: 9999, TransactionFeesEnabled: true}
+		_, err := pool.new(config)
+		require.NoError(t, err)
+
+		cached, ok := pool.configPools.Get(config)
+		require.True(t, ok)
+		configPool := cached.(*instancePool)
+		require.Eventually(t, func() bool {
+			return len(configPool.instances) == configPoolSize
+		}, time.Minute, 10*time.Millisecond)
+
+		fk, err := pool.new(config)
+		require.NoError(t, err)
+		assert.Equal(t, config, fk.config)
+		assert.Less(t, len(configPool.instances), configPoolSize)
+	})
 }
@@ -20,25 +20,35 @@ package blockchain
 
 import (
 	"fmt"
+	userErr "github.com/dapperlabs/flow-playground-api/middleware/errors"
 	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/dapperlabs/flow-playground-api/server/config"
 	"github.com/dapperlabs/flow-playground-api/storage"
 	"github.com/getsentry/sentry-go"
 	"github.com/google/uuid"
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/sema"
 	flowsdk "github.com/onflow/flow-go-sdk"
 	"github.com/pkg/errors"
+	"time"
 )
 
+// maxCommitBlocks is the maximum number of empty blocks committed at once.
+const maxCommitBlocks = 100
+
 // improvement: create instance pool as a possible optimization. We can pre-instantiate empty
 // instances of emulators waiting around to be assigned to a project if init time will be proved to be an issue
 
 // NewProjects creates an instance of the projects with provided storage access and caching.
 func NewProjects(store storage.Store, initAccountsNumber int) *Projects {
 	return &Projects{
-		store:          store,
-		flowKitCache:   newFlowKitCache(128),
-		mutex:          newMutex(),
-		accountsNumber: initAccountsNumber,
-		flowKitPool:    newFlowKitPool(10),
+		store:            store,
+		flowKitCache:     newFlowKitCache(config.Playground().LedgerCacheSize, config.Playground().LedgerCacheMaxBytes),
+		mutex:            newMutex(),
+		accountsNumber:   initAccountsNumber,
+		flowKitPool:      newFlowKitPool(10, model.DefaultEmulatorConfig()),
+		snapshotInterval: config.Playground().SnapshotInterval,
+		maxAccounts:      config.Playground().MaxAccountsLimit,
 	}
 }
 
@@ -47,228 +57,952 @@ func NewProjects(store storage.Store, initAccountsNumber int) *Projects {
 // Projects expose API to interact with the blockchain all in context of a project but also makes sure
 // the state is persisted and implements state recreation with caching and resource locking.
 type Projects struct {
-	store          storage.Store
-	flowKitCache   *flowKitCache
-	flowKitPool    *flowKitPool
-	mutex          *mutex
-	accountsNumber int
+	store            storage.Store
+	flowKitCache     *flowKitCache
+	flowKitPool      *flowKitPool
+	mutex            *mutex
+	accountsNumber   int
+	snapshotInterval int
+	maxAccounts      int
 }
 
-// Reset the blockchain state and return the new account models
+// Reset the blockchain state and recreate the project accounts.
 func (p *Projects) Reset(projectID uuid.UUID) error {
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+
+	_, err := p.reset(projectID)
+	return err
+}
+
+// reset clears the project state and rebuilds the emulator with the accounts created with the project.
+//
+// Do not call this method directly, it is not concurrency safe.
+func (p *Projects) reset(projectID uuid.UUID) (*flowKit, error) {
 	var project model.Project
 	err := p.store.GetProject(projectID, &project)
+	if err != nil {
+		return nil, err
+	}
+
+	p.flowKitCache.reset(projectID)
+
+	err = p.store.ResetProjectState(&project)
+	if err != nil {
+		return nil, err
+	}
+
+	fk, err := p.rebuildState(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.initAccounts(projectID, fk, project.NumberOfAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return fk, nil
+}
+
+// UpdateProject updates the project details.
+//
+// The emulator configuration can only be changed while the project has no history after its initial state,
+// because the history replayed with different settings could fail, the project must be reset first otherwise.
+func (p *Projects) UpdateProject(input model.UpdateProject, proj *model.Project) error {
+	if input.EmulatorConfig == nil {
+		return p.store.UpdateProject(input, proj)
+	}
+
+	p.mutex.load(input.ID).Lock()
+	defer p.mutex.remove(input.ID).Unlock()
+
+	var project model.Project
+	err := p.store.GetProject(input.ID, &project)
+	if err != nil {
+		return err
+	}
+
+	current := project.EmulatorConfig.WithDefaults()
+	if current.Apply(input.EmulatorConfig) == current {
+		input.EmulatorConfig = nil // avoid discarding the project state for no change
+		return p.store.UpdateProject(input, proj)
+	}
+
+	fk, err := p.load(input.ID)
+	if err != nil {
+		return err
+	}
+
+	height, err := fk.getLatestBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	if height > p.genesisHeight(fk, &project) {
+		return userErr.NewUserError(
+			"emulator configuration can't be changed after the project was used, reset the project first",
+		)
+	}
+
+	return p.store.UpdateProject(input, proj)
+}
+
+// Rollback the blockchain state to the provided block height by removing all the later executions and deployments.
+func (p *Projects) Rollback(projectID uuid.UUID, blockHeight int) error {
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return err
+	}
+
+	var project model.Project
+	err = p.store.GetProject(projectID, &project)
+	if err != nil {
+		return err
+	}
+
+	height, err := fk.getLatestBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	genesisHeight := p.genesisHeight(fk, &project)
+	if blockHeight < genesisHeight || blockHeight > height {
+		return userErr.NewUserError(fmt.Sprintf(
+			"block height must be between %d and %d",
+			genesisHeight,
+			height,
+		))
+	}
+
+	if blockHeight == height {
+		return nil
+	}
+
+	// Delete all contract deployments + transaction_executions after blockHeight
+	err = p.store.TruncateDeploymentsAndExecutionsAtBlockHeight(projectID, blockHeight+1)
+	if err != nil {
+		return err
+	}
+
+	// Reload emulator after block height rollback
+	p.flowKitCache.reset(projectID)
+	_, err = p.load(projectID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExecuteTransaction executes a transaction from the new transaction execution model and persists the execution.
+func (p *Projects) ExecuteTransaction(execution model.NewTransactionExecution) (_ *model.TransactionExecution, err error) {
+	projID := execution.ProjectID
+	p.mutex.load(projID).Lock()
+	defer p.mutex.remove(projID).Unlock()
+	fk, err := p.load(projID)
+	if err != nil {
+		return nil, err
+	}
+	defer p.discardUnrecorded(projID, fk, &err)()
+
+	signers := make([]flowsdk.Address, len(execution.Signers))
+	for i, sig := range execution.Signers {
+		signers[i] = sig.ToFlowAddress()
+	}
+
+	tx, result, logs, err := fk.executeTransaction(
+		execution.Script,
+		execution.Arguments,
+		transactionRoles{
+			proposer:    execution.ProposerToFlow(),
+			payer:       execution.PayerToFlow(),
+			authorizers: execution.SignersToFlow(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	blockHeight := int(block.Height)
+
+	exe := model.TransactionExecutionFromFlow(
+		execution.ProjectID,
+		result,
+		tx,
+		logs,
+		blockHeight,
+		fk.getTransactionUsage(tx.ID()),
+	)
+	exe.BlockTimestamp = block.Timestamp
+	// the recorded script keeps the string imports as they were written in the project
+	exe.Script = execution.Script
+	err = p.store.InsertTransactionExecution(exe)
+	if err != nil {
+		return nil, err
+	}
+
+	p.snapshotPeriodically(projID, fk, blockHeight)
+
+	if execution.IncludeStorageDiff != nil && *execution.IncludeStorageDiff {
+		exe.StorageDiffs = make([]*model.AccountStorageDiff, len(execution.Signers))
+		for i, signer := range execution.Signers {
+			exe.StorageDiffs[i], err = accountStorageDiff(fk, signer, blockHeight-1, blockHeight)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get authorizer storage diff")
+			}
+		}
+	}
+
+	return exe, nil
+}
+
+// SimulateTransaction executes a transaction on a copy of the project state and returns the result
+// together with the resulting state of the project accounts.
+//
+// Nothing is persisted and the project state is left unchanged.
+func (p *Projects) SimulateTransaction(execution model.NewTransactionExecution) (*model.TransactionSimulation, error) {
+	projID := execution.ProjectID
+	p.mutex.load(projID).RLock()
+	defer p.mutex.remove(projID).RUnlock()
+	fk, err := p.load(projID)
+	if err != nil {
+		return nil, err
+	}
+
+	var project model.Project
+	err = p.store.GetProject(projID, &project)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := fk.snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	simulation, err := newFlowkitFromSnapshot(snapshot, project.EmulatorConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, result, logs, err := simulation.executeTransaction(
+		execution.Script,
+		execution.Arguments,
+		transactionRoles{
+			proposer:    execution.ProposerToFlow(),
+			payer:       execution.PayerToFlow(),
+			authorizers: execution.SignersToFlow(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := accountAddresses(simulation)
+	accounts := make([]*model.Account, len(addresses))
+	for i, address := range addresses {
+		accounts[i], err = accountFromFlowKit(simulation, projID, address)
+		if err != nil {
+			return nil, err
+		}
+		accounts[i].Simulated = true
+
+		paths, err := model.StoragePathsFromState(accounts[i].State)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts[i].StorageSizes, err = simulation.getAccountStorageSizes(address.ToFlowAddress(), paths, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	exe := model.TransactionSimulationFromFlow(result, tx, logs, accounts)
+	exe.Script = execution.Script
+
+	return exe, nil
+}
+
+// ExecuteScript executes the script.
+func (p *Projects) ExecuteScript(execution model.NewScriptExecution) (*model.ScriptExecution, error) {
+	projID := execution.ProjectID
+	p.mutex.load(projID).RLock()
+	defer p.mutex.remove(projID).RUnlock()
+	fk, err := p.load(projID)
+	if err != nil {
+		return nil, err
+	}
+
+	if execution.BlockHeight != nil {
+		latestHeight, err := fk.getLatestBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+
+		if *execution.BlockHeight < 0 || *execution.BlockHeight > latestHeight {
+			return nil, userErr.NewUserError(fmt.Sprintf("block height must be between 0 and %d", latestHeight))
+		}
+
+		fk, err = p.loadHistory(projID, fk, *execution.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, logs, err := fk.executeScript(execution.Script, execution.Arguments, execution.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	exe := model.ScriptExecutionFromFlow(
+		result,
+		logs,
+		projID,
+		execution.Script,
+		execution.Arguments,
+	)
+	exe.BlockHeight = execution.BlockHeight
+	err = p.store.InsertScriptExecution(exe)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to insert script execution record")
+	}
+
+	return exe, nil
+}
+
+// CreateAccount creates a new account and return the account model as well as record the account creation.
+func (p *Projects) CreateAccount(projectID uuid.UUID) (_ *model.Account, err error) {
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	if len(accountAddresses(fk)) >= p.maxAccounts {
+		return nil, userErr.NewUserError(fmt.Sprintf("maximum number of %d accounts reached", p.maxAccounts))
+	}
+
+	address, err := p.createAccount(projectID, fk)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountFromFlowKit(fk, projectID, address)
+}
+
+// InitAccounts creates additional accounts, so the project has at least the provided number of accounts.
+func (p *Projects) InitAccounts(projectID uuid.UUID, numberOfAccounts int) (err error) {
+	if numberOfAccounts <= initialAccounts {
+		return nil // bootstrapped accounts are always available
+	}
+
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
 	if err != nil {
 		return err
 	}
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	return p.initAccounts(projectID, fk, numberOfAccounts)
+}
+
+func (p *Projects) initAccounts(projectID uuid.UUID, fk blockchain, numberOfAccounts int) error {
+	for i := len(accountAddresses(fk)); i < numberOfAccounts; i++ {
+		_, err := p.createAccount(projectID, fk)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// genesisHeight returns the height of the block creating the last account created with the project.
+//
+// The accounts created with the project are a part of its initial state, so the project can't be rolled back
+// below that height.
+func (p *Projects) genesisHeight(fk blockchain, project *model.Project) int {
+	if project.NumberOfAccounts <= initialAccounts {
+		return fk.initBlockHeight()
+	}
+
+	return fk.initBlockHeight() + project.NumberOfAccounts - initialAccounts
+}
+
+func (p *Projects) createAccount(projectID uuid.UUID, fk blockchain) (model.Address, error) {
+	flowAccount, err := fk.createAccount()
+	if err != nil {
+		return model.Address{}, err
+	}
+
+	blockHeight, err := fk.getLatestBlockHeight()
+	if err != nil {
+		return model.Address{}, err
+	}
+
+	address := model.NewAddressFromBytes(flowAccount.Address.Bytes())
+
+	err = p.recordOperation(projectID, fk, blockHeight, &model.Operation{
+		Type:    model.OperationCreateAccount,
+		Address: address,
+	})
+	if err != nil {
+		return model.Address{}, err
+	}
+
+	return address, nil
+}
+
+// recordOperation stores the operation already applied to the emulator in the project history.
+func (p *Projects) recordOperation(
+	projectID uuid.UUID,
+	fk blockchain,
+	blockHeight int,
+	operation *model.Operation,
+) error {
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return err
+	}
+
+	operation.ID = uuid.New()
+	operation.ProjectID = projectID
+	operation.BlockHeight = blockHeight
+	operation.Timestamp = block.Timestamp
+
+	err = p.store.InsertOperation(operation)
+	if err != nil {
+		// the emulator already contains the operation, so it must be recreated from the stored state
+		p.flowKitCache.reset(projectID)
+		return err
+	}
+
+	p.snapshotPeriodically(projectID, fk, blockHeight)
+
+	return nil
+}
+
+// DeployContract deploys a new contract to the provided address and return the updated account as well as record the execution.
+// If a contract with the same name is already deployed to this address, then it will be redeployed by rolling back
+// the project history to the initial deployment, unless update is set in which case the contract is updated in place
+// and the later history is preserved.
+func (p *Projects) DeployContract(
+	projectID uuid.UUID,
+	address model.Address,
+	script string,
+	arguments []string,
+	update bool,
+) (_ *model.ContractDeployment, err error) {
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	contractName, err := parseContractName(script)
+	if err != nil {
+		return nil, err
+	}
+
+	flowAccount, err := fk.getAccount(address.ToFlowAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	_, exists := flowAccount.Contracts[contractName]
+	if exists && !update {
+		// A contract with this name has already been deployed to this account
+		// Rollback to block height before this contract was initially deployed
+		var deployment model.ContractDeployment
+		err := p.store.GetContractDeploymentOnAddress(projectID, contractName, address, &deployment)
+		if err != nil {
+			return nil, err
+		}
+
+		blockHeight := deployment.BlockHeight
+
+		// Delete all contract deployments + transaction_executions >= blockHeight
+		err = p.store.TruncateDeploymentsAndExecutionsAtBlockHeight(projectID, blockHeight)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reload emulator after block height rollback
+		p.flowKitCache.reset(projectID)
+		fk, err = p.load(projectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	// only an update of an existing contract is recorded as an update, otherwise the contract is added
+	update = exists && update
+
+	tx, result, logs, err := fk.deployContract(address.ToFlowAddress(), script, arguments, update)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	blockHeight := int(block.Height)
+
+	deploy := model.ContractDeploymentFromFlow(
+		projectID,
+		contractName,
+		script,
+		arguments,
+		result,
+		tx,
+		logs,
+		blockHeight,
+		fk.getTransactionUsage(tx.ID()),
+	)
+	deploy.IsUpdate = update
+	deploy.BlockTimestamp = block.Timestamp
+
+	if result.Error != nil {
+		if !update {
+			return nil, result.Error
+		}
+
+		// an incompatible update is reported without being recorded, the failed block is discarded on the next load
+		p.flowKitCache.reset(projectID)
+		return deploy, nil
+	}
+
+	err = p.store.InsertContractDeployment(deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	p.snapshotPeriodically(projectID, fk, blockHeight)
+
+	return deploy, nil
+}
+
+// RemoveContract removes the contract with provided name from the account and records the removal.
+func (p *Projects) RemoveContract(projectID uuid.UUID, address model.Address, contractName string) (_ *model.Account, err error) {
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	flowAccount, err := fk.getAccount(address.ToFlowAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := flowAccount.Contracts[contractName]; !ok {
+		return nil, userErr.NewUserError(fmt.Sprintf(
+			"contract %s is not deployed to address 0x%s",
+			contractName,
+			address.ToFlowAddress().Hex(),
+		))
+	}
+
+	err = fk.removeContract(address.ToFlowAddress(), contractName)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeight, err := fk.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.recordOperation(projectID, fk, blockHeight, &model.Operation{
+		Type:         model.OperationRemoveContract,
+		Address:      address,
+		ContractName: contractName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accountFromFlowKit(fk, projectID, address)
+}
+
+// FundAccount mints the amount of FLOW tokens to the account and records the funding.
+func (p *Projects) FundAccount(projectID uuid.UUID, address model.Address, amount string) (_ *model.Account, err error) {
+	flowAmount, err := cadence.NewUFix64(amount)
+	if err != nil || flowAmount == 0 {
+		return nil, userErr.NewUserError(fmt.Sprintf("amount %s must be a positive UFix64 number", amount))
+	}
+
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	_, err = fk.getAccount(address.ToFlowAddress())
+	if err != nil {
+		return nil, userErr.NewUserError(fmt.Sprintf("account 0x%s doesn't exist", address.ToFlowAddress().Hex()))
+	}
+
+	// a failed transaction is still committed in a block, which is discarded on the next load
+	err = fk.fundAccount(address.ToFlowAddress(), flowAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeight, err := fk.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.recordOperation(projectID, fk, blockHeight, &model.Operation{
+		Type:    model.OperationFundAccount,
+		Address: address,
+		Amount:  flowAmount.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accountFromFlowKit(fk, projectID, address)
+}
+
+// CommitBlocks commits the number of empty blocks and returns the latest block.
+func (p *Projects) CommitBlocks(projectID uuid.UUID, count int) (_ *model.Block, err error) {
+	if count < 1 || count > maxCommitBlocks {
+		return nil, userErr.NewUserError(fmt.Sprintf("number of blocks must be between 1 and %d", maxCommitBlocks))
+	}
+
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	for i := 0; i < count; i++ {
+		err = fk.commitBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		blockHeight, err := fk.getLatestBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.recordOperation(projectID, fk, blockHeight, &model.Operation{
+			Type: model.OperationCommitBlock,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return model.BlockFromFlow(block), nil
+}
+
+// SetBlockTimestamp commits an empty block with the provided timestamp, the following blocks continue from it.
+func (p *Projects) SetBlockTimestamp(projectID uuid.UUID, timestamp time.Time) (_ *model.Block, err error) {
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	return p.setBlockTimestamp(projectID, fk, timestamp)
+}
+
+// AdvanceBlockTimestamp commits an empty block with the timestamp moved forward from the latest block.
+func (p *Projects) AdvanceBlockTimestamp(projectID uuid.UUID, duration time.Duration) (_ *model.Block, err error) {
+	if duration <= 0 {
+		return nil, userErr.NewUserError("block timestamp can only be advanced by a positive duration")
+	}
+
+	p.mutex.load(projectID).Lock()
+	defer p.mutex.remove(projectID).Unlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer p.discardUnrecorded(projectID, fk, &err)()
+
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.setBlockTimestamp(projectID, fk, block.Timestamp.Add(duration))
+}
+
+func (p *Projects) setBlockTimestamp(projectID uuid.UUID, fk blockchain, timestamp time.Time) (*model.Block, error) {
+	latest, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	if timestamp.Before(latest.Timestamp) {
+		return nil, userErr.NewUserError(fmt.Sprintf(
+			"block timestamp can't be earlier than the latest block timestamp %s",
+			latest.Timestamp.UTC().Format(time.RFC3339Nano),
+		))
+	}
+
+	err = fk.setBlockTimestamp(timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := fk.getLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// advancing is recorded as the resulting timestamp, so the replayed block is the same
+	err = p.recordOperation(projectID, fk, int(block.Height), &model.Operation{
+		Type: model.OperationSetBlockTimestamp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model.BlockFromFlow(block), nil
+}
+
+// CheckCode parses and type-checks the code against the project contracts and returns the found errors.
+func (p *Projects) CheckCode(projectID uuid.UUID, code string, kind model.CodeKind) ([]model.ProgramError, error) {
+	_, programErrors, err := p.CheckProgram(projectID, code, kind, nil)
+	return programErrors, err
+}
+
+// CheckProgram type-checks the code against the project contracts and returns the found errors
+// along with the checker holding the position info, the checker is nil if the code can't be parsed.
+//
+// String imports are resolved from the provided contract codes by the contract name before the deployed contracts.
+func (p *Projects) CheckProgram(
+	projectID uuid.UUID,
+	code string,
+	kind model.CodeKind,
+	contracts map[string]string,
+) (*sema.Checker, []model.ProgramError, error) {
+	p.mutex.load(projectID).RLock()
+	defer p.mutex.remove(projectID).RUnlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checker, err := fk.checkProgram(code, kind, contracts)
+	if err != nil {
+		return checker, model.ProgramErrorFromFlow(err), nil
+	}
+
+	return checker, []model.ProgramError{}, nil
+}
+
+// GetAccount by the address along with its storage information.
+func (p *Projects) GetAccount(projectID uuid.UUID, address model.Address) (*model.Account, error) {
+	p.mutex.load(projectID).RLock()
+	defer p.mutex.remove(projectID).RUnlock()
+	return p.getAccount(projectID, address)
+}
+
+// GetAllAccounts returns all the project user accounts along with their storage information.
+func (p *Projects) GetAllAccounts(projectID uuid.UUID) ([]*model.Account, error) {
+	p.mutex.load(projectID).RLock()
+	defer p.mutex.remove(projectID).RUnlock()
+	fk, err := p.load(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := accountAddresses(fk)
+	accounts := make([]*model.Account, len(addresses))
+	for i, address := range addresses {
+		accounts[i], err = accountFromFlowKit(fk, projectID, address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accounts, nil
+}
+
+func (p *Projects) GetAccounts(projectID uuid.UUID, addresses []model.Address) ([]*model.Account, error) {
+	p.mutex.load(projectID).RLock()
+	defer p.mutex.remove(projectID).RUnlock()
 
-	p.flowKitCache.reset(projectID)
+	accounts := make([]*model.Account, len(addresses))
+	for i, address := range addresses {
+		account, err := p.getAccount(projectID, address)
+		if err != nil {
+			return nil, err
+		}
 
-	err = p.store.ResetProjectState(&project)
-	if err != nil {
-		return err
+		accounts[i] = account
 	}
 
-	return nil
+	return accounts, nil
 }
 
-// ExecuteTransaction executes a transaction from the new transaction execution model and persists the execution.
-func (p *Projects) ExecuteTransaction(execution model.NewTransactionExecution) (*model.TransactionExecution, error) {
-	projID := execution.ProjectID
-	p.mutex.load(projID).Lock()
-	defer p.mutex.remove(projID).Unlock()
-	fk, err := p.load(projID)
+// GetAccountStorageDiff compares the account storage between the two block heights.
+func (p *Projects) GetAccountStorageDiff(
+	projectID uuid.UUID,
+	address model.Address,
+	fromHeight int,
+	toHeight int,
+) (*model.AccountStorageDiff, error) {
+	p.mutex.load(projectID).RLock()
+	defer p.mutex.remove(projectID).RUnlock()
+	fk, err := p.load(projectID)
 	if err != nil {
 		return nil, err
 	}
 
-	signers := make([]flowsdk.Address, len(execution.Signers))
-	for i, sig := range execution.Signers {
-		signers[i] = sig.ToFlowAddress()
-	}
-
-	tx, result, logs, err := fk.executeTransaction(
-		execution.Script,
-		execution.Arguments,
-		execution.SignersToFlow(),
-	)
+	latestHeight, err := fk.getLatestBlockHeight()
 	if err != nil {
 		return nil, err
 	}
 
-	blockHeight, err := fk.getLatestBlockHeight()
-	if err != nil {
-		return nil, err
+	if fromHeight < 0 || toHeight > latestHeight || fromHeight > toHeight {
+		return nil, userErr.NewUserError(fmt.Sprintf(
+			"block heights must be between 0 and %d and fromHeight can't be greater than toHeight",
+			latestHeight,
+		))
 	}
 
-	exe := model.TransactionExecutionFromFlow(execution.ProjectID, result, tx, logs, blockHeight)
-	err = p.store.InsertTransactionExecution(exe)
+	fk, err = p.loadHistory(projectID, fk, fromHeight)
 	if err != nil {
 		return nil, err
 	}
 
-	return exe, nil
+	return accountStorageDiff(fk, address, fromHeight, toHeight)
 }
 
-// ExecuteScript executes the script.
-func (p *Projects) ExecuteScript(execution model.NewScriptExecution) (*model.ScriptExecution, error) {
-	projID := execution.ProjectID
-	p.mutex.load(projID).RLock()
-	defer p.mutex.remove(projID).RUnlock()
-	fk, err := p.load(projID)
+// accountStorageDiff gets the account storage at both block heights and returns the changes between them.
+func accountStorageDiff(
+	fk blockchain,
+	address model.Address,
+	fromHeight int,
+	toHeight int,
+) (*model.AccountStorageDiff, error) {
+	fromStorage, err := fk.getAccountStorage(address.ToFlowAddress(), &fromHeight)
 	if err != nil {
 		return nil, err
 	}
 
-	result, logs, err := fk.executeScript(execution.Script, execution.Arguments)
+	toStorage, err := fk.getAccountStorage(address.ToFlowAddress(), &toHeight)
 	if err != nil {
 		return nil, err
 	}
 
-	exe := model.ScriptExecutionFromFlow(
-		result,
-		logs,
-		projID,
-		execution.Script,
-		execution.Arguments,
-	)
-	err = p.store.InsertScriptExecution(exe)
+	fromSizes, err := accountStorageSizesAt(fk, address, fromStorage, fromHeight)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to insert script execution record")
+		return nil, err
 	}
 
-	return exe, nil
-}
-
-// CreateAccount creates a new account and return the account model as well as record the execution.
-func (p *Projects) CreateAccount(projectID uuid.UUID) (*model.Account, error) {
-	// TODO: Delete this?
-	p.mutex.load(projectID).Lock()
-	defer p.mutex.remove(projectID).Unlock()
-	fk, err := p.load(projectID)
+	toSizes, err := accountStorageSizesAt(fk, address, toStorage, toHeight)
 	if err != nil {
 		return nil, err
 	}
 
-	flowAccount, err := fk.createAccount()
+	return model.AccountStorageDiffFromStates(address, fromHeight, toHeight, fromStorage, toStorage, fromSizes, toSizes)
+}
+
+// accountStorageSizesAt gets the storage sizes of all the paths of the account state at the block height.
+func accountStorageSizesAt(fk blockchain, address model.Address, state string, blockHeight int) (map[string]int, error) {
+	paths, err := model.StoragePathsFromState(state)
 	if err != nil {
 		return nil, err
 	}
 
-	address := model.NewAddressFromBytes(flowAccount.Address.Bytes())
-
-	return p.getAccount(projectID, address)
+	return fk.getAccountStorageSizes(address.ToFlowAddress(), paths, &blockHeight)
 }
 
-// DeployContract deploys a new contract to the provided address and return the updated account as well as record the execution.
-// If a contract with the same name is already deployed to this address, then it will be updated.
-func (p *Projects) DeployContract(
-	projectID uuid.UUID,
-	address model.Address,
-	script string,
-	arguments []string,
-) (*model.ContractDeployment, error) {
-	p.mutex.load(projectID).Lock()
-	defer p.mutex.remove(projectID).Unlock()
+func (p *Projects) getAccount(projectID uuid.UUID, address model.Address) (*model.Account, error) {
 	fk, err := p.load(projectID)
 	if err != nil {
 		return nil, err
 	}
 
-	contractName, err := parseContractName(script)
+	return accountFromFlowKit(fk, projectID, address)
+}
+
+// GetAccountStorage returns the account storage items limited by the filter,
+// only the values of the paths in the requested page are read from the account.
+func (p *Projects) GetAccountStorage(
+	projectID uuid.UUID,
+	address model.Address,
+	filter model.StorageFilter,
+) (*model.AccountStorage, error) {
+	p.mutex.load(projectID).RLock()
+	defer p.mutex.remove(projectID).RUnlock()
+	fk, err := p.load(projectID)
 	if err != nil {
 		return nil, err
 	}
 
-	flowAccount, err := fk.getAccount(address.ToFlowAddress())
+	paths, err := fk.getAccountStoragePaths(address.ToFlowAddress())
 	if err != nil {
 		return nil, err
 	}
 
-	if _, ok := flowAccount.Contracts[contractName]; ok {
-		// A contract with this name has already been deployed to this account
-		// Rollback to block height before this contract was initially deployed
-		var deployment model.ContractDeployment
-		err := p.store.GetContractDeploymentOnAddress(projectID, contractName, address, &deployment)
-		if err != nil {
-			return nil, err
-		}
-
-		blockHeight := deployment.BlockHeight
-
-		// Delete all contract deployments + transaction_executions >= blockHeight
-		err = p.store.TruncateDeploymentsAndExecutionsAtBlockHeight(projectID, blockHeight)
-		if err != nil {
-			return nil, err
-		}
-
-		// Reload emulator after block height rollback
-		p.flowKitCache.reset(projectID)
-		fk, err = p.load(projectID)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	tx, result, logs, err := fk.deployContract(address.ToFlowAddress(), script, arguments)
+	page, err := model.StoragePageFromPaths(paths, filter)
 	if err != nil {
 		return nil, err
 	}
-	if result.Error != nil {
-		return nil, result.Error
-	}
 
-	blockHeight, err := fk.getLatestBlockHeight()
+	values, err := fk.getAccountStorageValues(address.ToFlowAddress(), page.Paths())
 	if err != nil {
 		return nil, err
 	}
 
-	deploy := model.ContractDeploymentFromFlow(projectID, contractName, script, arguments, result, tx, logs, blockHeight)
-
-	err = p.store.InsertContractDeployment(deploy)
+	sizes, err := fk.getAccountStorageSizes(address.ToFlowAddress(), page.Paths(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return deploy, nil
-}
-
-// GetAccount by the address along with its storage information.
-func (p *Projects) GetAccount(projectID uuid.UUID, address model.Address) (*model.Account, error) {
-	p.mutex.load(projectID).RLock()
-	defer p.mutex.remove(projectID).RUnlock()
-	return p.getAccount(projectID, address)
-}
-
-func (p *Projects) GetAccounts(projectID uuid.UUID, addresses []model.Address) ([]*model.Account, error) {
-	p.mutex.load(projectID).RLock()
-	defer p.mutex.remove(projectID).RUnlock()
-
-	accounts := make([]*model.Account, len(addresses))
-	for i, address := range addresses {
-		account, err := p.getAccount(projectID, address)
-		if err != nil {
-			return nil, err
-		}
-
-		accounts[i] = account
-	}
-
-	return accounts, nil
+	return page.AccountStorage(values, sizes, filter.Depth)
 }
 
-func (p *Projects) getAccount(projectID uuid.UUID, address model.Address) (*model.Account, error) {
-	fk, err := p.load(projectID)
+// accountFromFlowKit gets the account by the address along with its storage information from the provided flowKit.
+func accountFromFlowKit(fk blockchain, projectID uuid.UUID, address model.Address) (*model.Account, error) {
+	flowAccount, err := fk.getAccount(address.ToFlowAddress())
 	if err != nil {
 		return nil, err
 	}
 
-	flowAccount, err := fk.getAccount(address.ToFlowAddress())
+	accountStorage, err := fk.getAccountStorage(address.ToFlowAddress(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	accountStorage, err := fk.getAccountStorage(address.ToFlowAddress())
+	availableBalance, err := fk.getAvailableBalance(address.ToFlowAddress())
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +1010,7 @@ func (p *Projects) getAccount(projectID uuid.UUID, address model.Address) (*mode
 	account := model.AccountFromFlow(flowAccount, projectID)
 	account.ProjectID = projectID
 	account.State = accountStorage
+	account.AvailableBalance = availableBalance.String()
 
 	return account, nil
 }
@@ -295,12 +1030,7 @@ func (p *Projects) GetFlowJson(projectID uuid.UUID) (string, error) {
 func (p *Projects) load(projectID uuid.UUID) (blockchain, error) {
 	fk, err := p.rebuildState(projectID)
 	if err != nil {
-		err = p.Reset(projectID)
-		if err != nil {
-			return nil, err
-		}
-
-		fk, err = p.rebuildState(projectID)
+		fk, err = p.reset(projectID)
 		if err != nil {
 			return nil, err
 		}
@@ -311,94 +1041,250 @@ func (p *Projects) load(projectID uuid.UUID) (blockchain, error) {
 	return fk, nil
 }
 
-func (p *Projects) rebuildState(projectID uuid.UUID) (*flowKit, error) {
+// getHistory returns all the recorded project executions, deployments and operations.
+func (p *Projects) getHistory(projectID uuid.UUID) (
+	[]*model.TransactionExecution,
+	[]*model.ContractDeployment,
+	[]*model.Operation,
+	error,
+) {
 	var executions []*model.TransactionExecution
 	err := p.store.GetTransactionExecutionsForProject(projectID, &executions)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	var deployments []*model.ContractDeployment
 	err = p.store.GetContractDeploymentsForProject(projectID, &deployments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var operations []*model.Operation
+	err = p.store.GetOperationsForProject(projectID, &operations)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return executions, deployments, operations, nil
+}
+
+// loadHistory returns a flowKit with the project state at the block height.
+//
+// The snapshots only contain the latest state, so if the loaded flowKit was restored from a snapshot
+// after the block height, the project is replayed from the start on a new flowKit which isn't cached.
+func (p *Projects) loadHistory(projectID uuid.UUID, fk blockchain, blockHeight int) (blockchain, error) {
+	if blockHeight >= fk.historyHeight() {
+		return fk, nil
+	}
+
+	executions, deployments, operations, err := p.getHistory(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var project model.Project
+	err = p.store.GetProject(projectID, &project)
+	if err != nil {
+		return nil, err
+	}
+
+	replayed, err := p.flowKitPool.new(project.EmulatorConfig.WithDefaults())
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := replayed.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	replayed, err = p.runMissingBlocks(projectID, replayed, height, executions, deployments, operations)
 	if err != nil {
 		return nil, err
 	}
 
+	return replayed, nil
+}
+
+func (p *Projects) rebuildState(projectID uuid.UUID) (*flowKit, error) {
+	executions, deployments, operations, err := p.getHistory(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var project model.Project
+	err = p.store.GetProject(projectID, &project)
+	if err != nil {
+		return nil, err
+	}
+	emulatorConfig := project.EmulatorConfig.WithDefaults()
+
 	fk := p.flowKitCache.get(projectID)
-	if fk == nil { // if cache miss create new flowKit
-		fk, err = p.flowKitPool.new()
+	// project might have been reset or rolled back, or its emulator configuration changed, on another replica
+	if fk != nil && (fk.stateVersion != project.StateVersion || fk.config != emulatorConfig) {
+		p.flowKitCache.reset(projectID)
+		fk = nil
+	}
+
+	if fk == nil { // if cache miss restore flowKit from the latest snapshot
+		fk, err = p.restoreFlowKit(projectID, emulatorConfig)
 		if err != nil {
 			return nil, err
 		}
+		fk.stateVersion = project.StateVersion
 	}
 
+	// the cached emulator never contains unrecorded blocks, as the failed changes discard it
 	height, err := fk.getLatestBlockHeight()
 	if err != nil {
 		return nil, err
 	}
 
-	// This can happen if project was cleared but on another replica, this replica gets the request after
-	// and will get cleared 0 executions from database but has a stale emulator in its own cache
-	// This also occurs when a rollback is required due to contract redeployment
-	if height > len(executions)+len(deployments)+fk.numAccounts() {
-		p.flowKitCache.reset(projectID)
-		fk, err = p.flowKitPool.new()
-		if err != nil {
-			return nil, err
+	startHeight := height
+	fk, err = p.runMissingBlocks(projectID, fk, height, executions, deployments, operations)
+	if err != nil {
+		return nil, err
+	}
+
+	// avoid replaying the same blocks on the next cold load
+	latestHeight := fk.initBlockHeight() + len(executions) + len(deployments) + len(operations)
+	if p.snapshotInterval > 0 && latestHeight-startHeight >= p.snapshotInterval {
+		p.snapshot(projectID, fk, latestHeight)
+	}
+
+	return fk, nil
+}
+
+// discardUnrecorded returns the function deferred by the project changes, which resets the cached emulator
+// if the change failed after committing blocks, so the emulator is recreated from the recorded history on the next load.
+func (p *Projects) discardUnrecorded(projectID uuid.UUID, fk blockchain, err *error) func() {
+	startHeight, startErr := fk.getLatestBlockHeight()
+
+	return func() {
+		if *err == nil {
+			return
+		}
+
+		height, heightErr := fk.getLatestBlockHeight()
+		if startErr != nil || heightErr != nil || height != startHeight {
+			p.flowKitCache.reset(projectID)
 		}
-		height = fk.initBlockHeight()
 	}
+}
 
-	fk, err = p.runMissingBlocks(projectID, fk, height, executions, deployments)
+// restoreFlowKit creates a flowKit from the latest project snapshot or a new one if the project has no snapshots.
+func (p *Projects) restoreFlowKit(projectID uuid.UUID, emulatorConfig model.EmulatorConfig) (*flowKit, error) {
+	var snapshot model.Snapshot
+	err := p.store.GetLatestSnapshot(projectID, &snapshot)
+	if errors.Is(err, storage.ErrNotFound) {
+		return p.flowKitPool.new(emulatorConfig)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	fk, err := newFlowkitFromSnapshot(snapshot.Data, emulatorConfig)
+	if err != nil {
+		// a broken snapshot shouldn't prevent the project from loading, we can still replay all the blocks
+		sentry.CaptureException(errors.Wrap(err, fmt.Sprintf("failed to restore project %s snapshot", projectID)))
+		return p.flowKitPool.new(emulatorConfig)
+	}
+
 	return fk, nil
 }
 
+// snapshotPeriodically creates a project snapshot on every snapshot interval of blocks.
+func (p *Projects) snapshotPeriodically(projectID uuid.UUID, fk blockchain, blockHeight int) {
+	if p.snapshotInterval <= 0 { // snapshots are disabled
+		return
+	}
+
+	if (blockHeight-fk.initBlockHeight())%p.snapshotInterval != 0 {
+		return
+	}
+
+	p.snapshot(projectID, fk, blockHeight)
+}
+
+// snapshot persists the emulator state of the project at the block height.
+//
+// Failing to create a snapshot doesn't affect the project state, so the error is only reported.
+func (p *Projects) snapshot(projectID uuid.UUID, fk blockchain, blockHeight int) {
+	data, err := fk.snapshot()
+	if err != nil {
+		sentry.CaptureException(errors.Wrap(err, "failed to create project snapshot"))
+		return
+	}
+
+	err = p.store.InsertSnapshot(&model.Snapshot{
+		ProjectID:   projectID,
+		BlockHeight: blockHeight,
+		Data:        data,
+	})
+	if err != nil {
+		sentry.CaptureException(errors.Wrap(err, "failed to store project snapshot"))
+	}
+}
+
 func (p *Projects) getExecutionOrDeploymentAtHeight(
 	height int,
 	exes []*model.TransactionExecution,
 	deploys []*model.ContractDeployment,
-) (*model.TransactionExecution, *model.ContractDeployment, error) {
+	operations []*model.Operation,
+) (*model.TransactionExecution, *model.ContractDeployment, *model.Operation, error) {
 	for _, exec := range exes {
 		if exec.BlockHeight == height {
-			return exec, nil, nil
+			return exec, nil, nil, nil
 		}
 	}
 
 	for _, deploy := range deploys {
 		if deploy.BlockHeight == height {
-			return nil, deploy, nil
+			return nil, deploy, nil, nil
+		}
+	}
+
+	for _, operation := range operations {
+		if operation.BlockHeight == height {
+			return nil, nil, operation, nil
 		}
 	}
 
-	return nil, nil, errors.Errorf("no execution or deployment found at height %d", height)
+	return nil, nil, nil, errors.Errorf("no execution, deployment or operation found at height %d", height)
 }
 
-// runMissingBlocks executes missing transactions and deploys missing contracts
-// occurring after the specified height
+// runMissingBlocks executes missing transactions, deploys missing contracts and
+// replays missing operations occurring after the specified height
 func (p *Projects) runMissingBlocks(
 	projectID uuid.UUID,
 	fk *flowKit,
 	height int,
 	exes []*model.TransactionExecution,
 	deploys []*model.ContractDeployment,
+	operations []*model.Operation,
 ) (*flowKit, error) {
-	totalBlockHeight := fk.initBlockHeight() + len(exes) + len(deploys)
+	totalBlockHeight := fk.initBlockHeight() + len(exes) + len(deploys) + len(operations)
+	if height >= totalBlockHeight {
+		return fk, nil
+	}
 
 	for height < totalBlockHeight {
 		// Add next missing block
-		txExec, deploy, err := p.getExecutionOrDeploymentAtHeight(height+1, exes, deploys)
+		txExec, deploy, operation, err := p.getExecutionOrDeploymentAtHeight(height+1, exes, deploys, operations)
 		if err != nil {
 			return nil, err
 		}
 		if txExec != nil {
+			fk.gateway.setPendingTimestamp(txExec.BlockTimestamp)
 			_, result, _, err := fk.executeTransaction(
 				txExec.Script,
 				txExec.Arguments,
-				txExec.SignersToFlow(),
+				transactionRoles{
+					proposer:    txExec.ProposerToFlow(),
+					payer:       txExec.PayerToFlow(),
+					authorizers: txExec.SignersToFlow(),
+				},
 			)
 			if err != nil {
 				return nil, stateRecreationError(projectID, txExec.ID, err)
@@ -407,25 +1293,98 @@ func (p *Projects) runMissingBlocks(
 				return nil, transactionResultError(projectID, txExec.ID, result.Error)
 			}
 		} else if deploy != nil {
-			_, result, _, err := fk.deployContract(deploy.Address.ToFlowAddress(), deploy.Script, deploy.Arguments)
+			fk.gateway.setPendingTimestamp(deploy.BlockTimestamp)
+			_, result, _, err := fk.deployContract(
+				deploy.Address.ToFlowAddress(),
+				deploy.Script,
+				deploy.Arguments,
+				deploy.IsUpdate,
+			)
 			if err != nil {
 				return nil, stateRecreationError(projectID, deploy.ID, err)
 			}
-			if result.Error != nil && len(deploys[0].Errors) == 0 {
+			if result.Error != nil && len(deploy.Errors) == 0 {
 				return nil, transactionResultError(projectID, deploy.ID, result.Error)
 			}
+		} else if operation != nil {
+			fk.gateway.setPendingTimestamp(operation.Timestamp)
+			err := replayOperation(fk, operation)
+			if err != nil {
+				return nil, stateRecreationError(projectID, operation.ID, err)
+			}
 		} else {
 			// This should never happen
-			err := fmt.Errorf("no execution or deployment found for block height %d", height+1)
+			err := fmt.Errorf("no execution, deployment or operation found for block height %d", height+1)
 			sentry.CaptureException(err)
 			return nil, err
 		}
 		height++
 	}
 
+	err := fk.gateway.resumeClock(clockOffset(operations))
+	if err != nil {
+		return nil, err
+	}
+
 	return fk, nil
 }
 
+// clockOffset returns the offset of the block timestamps from the system time set by the latest
+// block timestamp change, so the next blocks continue from the moved time after the project is loaded.
+func clockOffset(operations []*model.Operation) time.Duration {
+	var latest *model.Operation
+	for _, operation := range operations {
+		if operation.Type != model.OperationSetBlockTimestamp {
+			continue
+		}
+		if latest == nil || operation.BlockHeight > latest.BlockHeight {
+			latest = operation
+		}
+	}
+
+	if latest == nil || latest.CreatedAt.IsZero() {
+		return 0
+	}
+	return latest.Timestamp.Sub(latest.CreatedAt)
+}
+
+// replayOperation applies the recorded operation to the emulator state.
+func replayOperation(fk blockchain, operation *model.Operation) error {
+	switch operation.Type {
+	case model.OperationCreateAccount:
+		account, err := fk.createAccount()
+		if err != nil {
+			return err
+		}
+
+		// accounts are created on sequential addresses, so a different address means the history is inconsistent
+		if model.NewAddressFromBytes(account.Address.Bytes()) != operation.Address {
+			return fmt.Errorf(
+				"created account address %s doesn't match the recorded address %s",
+				account.Address.String(),
+				operation.Address.ToFlowAddress().String(),
+			)
+		}
+	case model.OperationRemoveContract:
+		return fk.removeContract(operation.Address.ToFlowAddress(), operation.ContractName)
+	case model.OperationCommitBlock:
+		return fk.commitBlock()
+	case model.OperationSetBlockTimestamp:
+		return fk.setBlockTimestamp(operation.Timestamp)
+	case model.OperationFundAccount:
+		amount, err := cadence.NewUFix64(operation.Amount)
+		if err != nil {
+			return err
+		}
+
+		return fk.fundAccount(operation.Address.ToFlowAddress(), amount)
+	default:
+		return fmt.Errorf("unknown operation type %s", operation.Type)
+	}
+
+	return nil
+}
+
 func stateRecreationError(
 	projectID uuid.UUID, exeID uuid.UUID, err error) error {
 	err = errors.Wrap(err, fmt.Sprintf(
@@ -24,10 +24,13 @@ import (
 	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/dapperlabs/flow-playground-api/storage"
 	"github.com/google/uuid"
+	"github.com/onflow/cadence"
 	flowsdk "github.com/onflow/flow-go-sdk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"sync"
 	"testing"
+	"time"
 )
 
 const accountsNumber = 5
@@ -211,6 +214,66 @@ func Test_LoadFlowKit(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, fk.initBlockHeight(), latest) // no exe since reset
 	})
+
+	// the rollback followed by a new deployment results in the same height, so the outdated emulator
+	// can only be detected by the state version
+	t.Run("rollback project on another replica", func(t *testing.T) {
+		projects, store, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+		replica := NewProjects(store, accountsNumber)
+
+		address := model.NewAddressFromIndex(0)
+		_, err = projects.DeployContract(proj.ID, address, `pub contract A {}`, nil, false)
+		require.NoError(t, err)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		err = replica.Rollback(proj.ID, fk.initBlockHeight())
+		require.NoError(t, err)
+
+		_, err = replica.DeployContract(proj.ID, address, `pub contract B {}`, nil, false)
+		require.NoError(t, err)
+
+		account, err := projects.GetAccount(proj.ID, address)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"B"}, account.DeployedContracts)
+	})
+
+	t.Run("evicts emulators invalidated on another replica", func(t *testing.T) {
+		projects, store, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+		replica := NewProjects(store, accountsNumber)
+
+		deleted, files := projectSeed()
+		err = store.CreateProject(deleted, files)
+		require.NoError(t, err)
+
+		for _, ID := range []uuid.UUID{proj.ID, deleted.ID} {
+			_, err = projects.ExecuteTransaction(model.NewTransactionExecution{
+				ProjectID: ID,
+				Script:    `transaction {}`,
+			})
+			require.NoError(t, err)
+		}
+
+		err = replica.Reset(proj.ID)
+		require.NoError(t, err)
+
+		err = store.DeleteProject(deleted.ID)
+		require.NoError(t, err)
+
+		projects.checkStateVersions()
+		assert.Nil(t, projects.flowKitCache.get(proj.ID))
+		assert.Nil(t, projects.flowKitCache.get(deleted.ID))
+
+		// the emulator of the current state isn't evicted
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		projects.checkStateVersions()
+		assert.Equal(t, fk, projects.flowKitCache.get(proj.ID))
+	})
 }
 
 func Test_TransactionExecution(t *testing.T) {
@@ -243,11 +306,20 @@ func Test_TransactionExecution(t *testing.T) {
 
 		assert.Equal(t, proj.ID, exe.ProjectID)
 		require.Len(t, exe.Logs, 1)
-		assert.Equal(t, `{"level":"debug","message":"Cadence log: \"hello\""}`, exe.Logs[0])
+		assert.Equal(t, `"hello"`, exe.Logs[0])
+		require.Len(t, exe.LogEntries, 1)
+		assert.Equal(t, `"hello"`, exe.LogEntries[0].Message)
+		assert.Equal(t, "debug", exe.LogEntries[0].Level)
+		assert.Equal(t, 1, exe.LogEntries[0].Line)
+		require.NotNil(t, exe.LogEntries[0].TransactionID)
+		assert.Equal(t, exe.TransactionID, *exe.LogEntries[0].TransactionID)
 		assert.Equal(t, script, exe.Script)
 		assert.Equal(t, []string{}, exe.Arguments)
 		assert.Equal(t, signers, exe.Signers)
 		assert.Equal(t, 0, exe.Index)
+		assert.Len(t, exe.TransactionID, 64)
+		assert.Equal(t, "SEALED", exe.Status)
+		assert.Equal(t, "0.00000000", exe.Fee)
 
 		var dbExe []*model.TransactionExecution
 		err = store.GetTransactionExecutionsForProject(proj.ID, &dbExe)
@@ -256,6 +328,36 @@ func Test_TransactionExecution(t *testing.T) {
 		require.Len(t, dbExe, 1)
 		assert.Equal(t, exe.ID, dbExe[0].ID)
 		assert.Equal(t, script, dbExe[0].Script)
+		assert.Equal(t, exe.TransactionInfo, dbExe[0].TransactionInfo)
+	})
+
+	t.Run("transaction execution reports computation used", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		execute := func(iterations int) *model.TransactionExecution {
+			exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+				ProjectID: proj.ID,
+				Script: fmt.Sprintf(`
+					transaction {
+						execute {
+							var i = 0
+							while i < %d {
+								i = i + 1
+							}
+						}
+					}`, iterations),
+			})
+			require.NoError(t, err)
+			require.Empty(t, exe.Errors)
+			return exe
+		}
+
+		cheap := execute(1_000)
+		expensive := execute(10_000)
+
+		assert.Greater(t, cheap.ComputationUsed, 0)
+		assert.Greater(t, expensive.ComputationUsed, cheap.ComputationUsed)
+		assert.NotEqual(t, cheap.TransactionID, expensive.TransactionID)
 	})
 
 	t.Run("multiple transaction execution", func(t *testing.T) {
@@ -287,7 +389,7 @@ func Test_TransactionExecution(t *testing.T) {
 
 			assert.Equal(t, proj.ID, exe.ProjectID)
 			require.Len(t, exe.Logs, 1)
-			assert.Equal(t, `{"level":"debug","message":"Cadence log: \"hello\""}`, exe.Logs[0])
+			assert.Equal(t, `"hello"`, exe.Logs[0])
 			assert.Equal(t, script, exe.Script)
 			assert.Equal(t, []string{}, exe.Arguments)
 			assert.Equal(t, signers, exe.Signers)
@@ -300,6 +402,7 @@ func Test_TransactionExecution(t *testing.T) {
 			require.Len(t, dbExe, i+1)
 			assert.Equal(t, exe.ID, dbExe[i].ID)
 			assert.Equal(t, script, dbExe[i].Script)
+			assert.Equal(t, exe.LogEntries, dbExe[i].LogEntries)
 		}
 	})
 
@@ -352,6 +455,84 @@ func Test_TransactionExecution(t *testing.T) {
 		}
 	})
 
+	t.Run("transaction with payer and proposer, reset cache", func(t *testing.T) {
+		projects, store := newProjects()
+		proj, files := projectSeed()
+		// sequence numbers are only incremented with transaction validation enabled
+		proj.EmulatorConfig = model.EmulatorConfig{TransactionValidationEnabled: true}
+		err := store.CreateProject(proj, files)
+		require.NoError(t, err)
+
+		authorizer := model.NewAddressFromIndex(0)
+		payer := model.NewAddressFromIndex(1)
+		proposer := model.NewAddressFromIndex(2)
+
+		tx := model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				transaction {
+					prepare (signer: AuthAccount) {}
+				}`,
+			Signers:  []model.Address{authorizer},
+			Payer:    &payer,
+			Proposer: &proposer,
+		}
+
+		exe, err := projects.ExecuteTransaction(tx)
+		require.NoError(t, err)
+		require.Empty(t, exe.Errors)
+		assert.Equal(t, []model.Address{authorizer}, exe.Signers)
+		assert.Equal(t, &payer, exe.Payer)
+		assert.Equal(t, &proposer, exe.Proposer)
+
+		var dbExe []*model.TransactionExecution
+		err = store.GetTransactionExecutionsForProject(proj.ID, &dbExe)
+		require.NoError(t, err)
+		require.Len(t, dbExe, 1)
+		assert.Equal(t, &payer, dbExe[0].Payer)
+		assert.Equal(t, &proposer, dbExe[0].Proposer)
+
+		// replaying the transaction must use the proposer key again
+		projects.flowKitCache.reset(proj.ID)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		account, err := fk.getAccount(proposer.ToFlowAddress())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), account.Keys[0].SequenceNumber)
+
+		_, err = projects.ExecuteTransaction(tx)
+		require.NoError(t, err)
+	})
+
+	t.Run("transaction with non-existent payer", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		payer := model.NewAddressFromIndex(20)
+		_, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction {}`,
+			Payer:     &payer,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "account 0x0000000000000019 doesn't exist")
+	})
+
+	t.Run("transaction without payer and proposer uses the service account", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction {}`,
+		})
+		require.NoError(t, err)
+
+		service := model.NewAddressFromString("0x01")
+		assert.Equal(t, &service, exe.Payer)
+		assert.Equal(t, &service, exe.Proposer)
+	})
+
 	t.Run("transaction with contract import and cache reset", func(t *testing.T) {
 		projects, _, proj, _ := newWithSeededProject()
 
@@ -361,7 +542,7 @@ func Test_TransactionExecution(t *testing.T) {
 				pub init() { self.A = "HelloWorldA" }
 			}`
 
-		deployment, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), scriptA, nil)
+		deployment, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), scriptA, nil, false)
 		require.NoError(t, err)
 
 		var deployments []*model.ContractDeployment
@@ -409,7 +590,7 @@ func Test_DeployContract(t *testing.T) {
 
 		script := `pub contract HelloWorld {}`
 
-		deployment, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), script, nil)
+		deployment, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), script, nil, false)
 		require.NoError(t, err)
 		assert.Equal(t, "HelloWorld", deployment.Title)
 
@@ -420,6 +601,10 @@ func Test_DeployContract(t *testing.T) {
 
 		deploy := deployments[0]
 		assert.Equal(t, "flow.AccountContractAdded", deploy.Events[0].Type)
+		assert.Equal(t, deployment.TransactionInfo, deploy.TransactionInfo)
+		assert.Len(t, deploy.TransactionID, 64)
+		assert.Equal(t, "SEALED", deploy.Status)
+		assert.Greater(t, deploy.ComputationUsed, 0)
 	})
 
 	t.Run("multiple deploys with imports and cache reset", func(t *testing.T) {
@@ -451,11 +636,11 @@ func Test_DeployContract(t *testing.T) {
 				}
 			}`
 
-		deploy1, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), scriptA, nil)
+		deploy1, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), scriptA, nil, false)
 		require.NoError(t, err)
 		assert.Equal(t, "HelloWorldA", deploy1.Title)
 
-		deploy2, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(1), scriptB, nil)
+		deploy2, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(1), scriptB, nil, false)
 		require.NoError(t, err)
 		assert.Equal(t, "HelloWorldB", deploy2.Title)
 
@@ -470,7 +655,7 @@ func Test_DeployContract(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, deployments, 2)
 
-		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(2), scriptC, nil)
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(2), scriptC, nil, false)
 		require.NoError(t, err)
 
 		err = store.GetContractDeploymentsForProject(proj.ID, &deployments)
@@ -482,11 +667,11 @@ func Test_DeployContract(t *testing.T) {
 		assert.Equal(t, "flow.AccountContractAdded", deployments[0].Events[0].Type)
 
 		assert.Equal(t,
-			`{"level":"debug","message":"Cadence log: \"HelloWorldA\""}`,
+			`"HelloWorldA"`,
 			deployments[1].Logs[0])
 
 		assert.Equal(t,
-			`{"level":"debug","message":"Cadence log: \"HelloWorldB\""}`,
+			`"HelloWorldB"`,
 			deployments[2].Logs[1])
 	})
 
@@ -503,14 +688,103 @@ func Test_DeployContract(t *testing.T) {
 			`{"type":"Int","value":"42"}`,
 		}
 
-		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil)
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
 		require.Error(t, err)
 
-		deployment, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, args)
+		deployment, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, args, false)
 		require.NoError(t, err)
 		require.Equal(t, args, deployment.Arguments)
 	})
 
+	t.Run("update contract preserving later history", func(t *testing.T) {
+		projects, store, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.DeployContract(proj.ID, address, `
+			pub contract HelloWorld {
+				pub var A: String
+				pub init() { self.A = "Hello" }
+			}`, nil, false)
+		require.NoError(t, err)
+
+		_, err = projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction { prepare (signer: AuthAccount) {} }`,
+			Signers:   []model.Address{address},
+		})
+		require.NoError(t, err)
+
+		deployment, err := projects.DeployContract(proj.ID, address, `
+			pub contract HelloWorld {
+				pub var A: String
+				pub fun hello(): String { return self.A }
+				pub init() { self.A = "Hello" }
+			}`, nil, true)
+		require.NoError(t, err)
+		require.Empty(t, deployment.Errors)
+		assert.True(t, deployment.IsUpdate)
+		assert.Equal(t, "flow.AccountContractUpdated", deployment.Events[0].Type)
+
+		var executions []*model.TransactionExecution
+		err = store.GetTransactionExecutionsForProject(proj.ID, &executions)
+		require.NoError(t, err)
+		assert.Len(t, executions, 1)
+
+		// update is replayed when the state is recreated
+		projects.flowKitCache.reset(proj.ID)
+
+		exe, err := projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import HelloWorld from 0x05
+				pub fun main(): String { return HelloWorld.hello() }`,
+		})
+		require.NoError(t, err)
+		require.Empty(t, exe.Errors)
+		assert.Equal(t, `"Hello"`, exe.Value)
+
+		// redeployment rolls back to the initial deployment and not the update
+		_, err = projects.DeployContract(proj.ID, address, `pub contract HelloWorld {}`, nil, false)
+		require.NoError(t, err)
+
+		err = store.GetTransactionExecutionsForProject(proj.ID, &executions)
+		require.NoError(t, err)
+		assert.Len(t, executions, 0)
+	})
+
+	t.Run("incompatible contract update", func(t *testing.T) {
+		projects, store, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.DeployContract(proj.ID, address, `
+			pub contract HelloWorld {
+				pub var A: String
+				pub init() { self.A = "Hello" }
+			}`, nil, false)
+		require.NoError(t, err)
+
+		deployment, err := projects.DeployContract(proj.ID, address, `
+			pub contract HelloWorld {
+				pub var A: Int
+				pub init() { self.A = 1 }
+			}`, nil, true)
+		require.NoError(t, err)
+		require.Len(t, deployment.Errors, 1)
+		assert.Contains(t, deployment.Errors[0].Message, "mismatching field `A` in `HelloWorld`")
+
+		var deployments []*model.ContractDeployment
+		err = store.GetContractDeploymentsForProject(proj.ID, &deployments)
+		require.NoError(t, err)
+		assert.Len(t, deployments, 1)
+
+		// the failed update is discarded from the project state
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		height, err := fk.getLatestBlockHeight()
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+1, height)
+	})
+
 	t.Run("deploy contract with new import syntax", func(t *testing.T) {
 		projects, _, proj, _ := newWithSeededProject()
 
@@ -528,10 +802,10 @@ func Test_DeployContract(t *testing.T) {
 			pub init() { self.B = HelloWorld.A }
 		}`
 
-		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil)
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
 		require.NoError(t, err)
 
-		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), importContract, nil)
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), importContract, nil, false)
 		require.NoError(t, err)
 	})
 
@@ -545,105 +819,1424 @@ func Test_DeployContract(t *testing.T) {
 		
 		pub contract Test {}`
 
-		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil)
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
 		require.NoError(t, err)
 	})
 }
 
-func Test_ScriptExecution(t *testing.T) {
-
-	t.Run("single script execution", func(t *testing.T) {
-		projects, store, proj, _ := newWithSeededProject()
+func Test_StringImports(t *testing.T) {
 
-		script := `pub fun main(): Int { 
-			log("purpose")
-			log("haha")
-			log("test")
-			return 42 
+	const contract = `
+		pub contract HelloWorld {
+			pub var A: Int
+			pub fun increment() { self.A = self.A + 1 }
+			pub init() { self.A = 5 }
 		}`
 
-		scriptExe := model.NewScriptExecution{
-			ProjectID: proj.ID,
-			Script:    script,
-			Arguments: nil,
-		}
+	t.Run("transaction and script with string imports, with cache reset", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		projects.snapshotInterval = 1
 
-		exe, err := projects.ExecuteScript(scriptExe)
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
 		require.NoError(t, err)
-		assert.Len(t, exe.Errors, 0)
-		assert.Equal(t, `{"level":"debug","message":"Cadence log: \"purpose\""}`, exe.Logs[0])
-		assert.Equal(t, "42", exe.Value)
-		assert.Equal(t, proj.ID, exe.ProjectID)
 
-		var dbScripts []*model.ScriptExecution
-		err = store.GetScriptExecutionsForProject(proj.ID, &dbScripts)
+		const tx = `
+			import "HelloWorld"
+			import FungibleToken from "FungibleToken"
+
+			transaction { execute { HelloWorld.increment() } }`
+
+		exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    tx,
+		})
 		require.NoError(t, err)
+		require.Empty(t, exe.Errors)
+		assert.Equal(t, tx, exe.Script)
 
-		require.Len(t, dbScripts, 1)
-		assert.Equal(t, dbScripts[0].Script, script)
+		// imports are resolved from the emulator state restored from a snapshot
+		projects.flowKitCache.reset(proj.ID)
+
+		scriptExe, err := projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import "HelloWorld"
+				pub fun main(): Int { return HelloWorld.A }`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "6", scriptExe.Value)
 	})
 
-	t.Run("script execution importing deployed contract, with cache reset", func(t *testing.T) {
+	t.Run("unresolved import", func(t *testing.T) {
 		projects, _, proj, _ := newWithSeededProject()
 
-		scriptA := `
-			pub contract HelloWorldA {
-				pub var A: String
-				pub init() { self.A = "HelloWorldA" }
-			}`
+		_, err := projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import "Missing"
+				pub fun main() {}`,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "import Missing could not be resolved")
+	})
+
+	t.Run("contract addresses are cached until the next block", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
 
-		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), scriptA, nil)
+		addresses, err := fk.(*flowKit).contractAddresses()
 		require.NoError(t, err)
+		assert.NotContains(t, addresses, "HelloWorld")
 
-		script := `
-			import HelloWorldA from 0x05
-			pub fun main(): String { 
-				return HelloWorldA.A
-			}`
+		cached, err := fk.(*flowKit).contractAddresses()
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%p", addresses), fmt.Sprintf("%p", cached))
 
-		scriptExe := model.NewScriptExecution{
-			ProjectID: proj.ID,
-			Script:    script,
-			Arguments: nil,
-		}
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
+		require.NoError(t, err)
 
-		exe, err := projects.ExecuteScript(scriptExe)
+		addresses, err = fk.(*flowKit).contractAddresses()
 		require.NoError(t, err)
-		assert.Equal(t, "\"HelloWorldA\"", exe.Value)
+		assert.Equal(t, []flowsdk.Address{model.NewAddressFromIndex(0).ToFlowAddress()}, addresses["HelloWorld"])
 	})
 
-	t.Run("script with arguments", func(t *testing.T) {
+	t.Run("ambiguous import", func(t *testing.T) {
 		projects, _, proj, _ := newWithSeededProject()
 
-		script := `pub fun main(a: Int): Int { 
-			return a
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
+		require.NoError(t, err)
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(1), contract, nil, false)
+		require.NoError(t, err)
+
+		_, err = projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import "HelloWorld"
+				pub fun main(): Int { return HelloWorld.A }`,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "import HelloWorld is ambiguous")
+
+		// the deployment imports the contract from its own account
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(1), `
+			import "HelloWorld"
+			pub contract Importer {
+				pub var B: Int
+				init() { self.B = HelloWorld.A }
+			}`, nil, false)
+		require.NoError(t, err)
+	})
+}
+
+func Test_CheckCode(t *testing.T) {
+
+	const contract = `
+		pub contract HelloWorld {
+			pub var A: Int
+			pub fun increment() { self.A = self.A + 1 }
+			pub init() { self.A = 5 }
 		}`
 
-		scriptExe := model.NewScriptExecution{
+	t.Run("valid code importing deployed contracts", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
+		require.NoError(t, err)
+
+		programErrors, err := projects.CheckCode(proj.ID, `
+			import "HelloWorld"
+			import FungibleToken from 0x02
+
+			transaction { execute { HelloWorld.increment() } }`,
+			model.CodeKindTransaction,
+		)
+		require.NoError(t, err)
+		assert.Empty(t, programErrors)
+
+		programErrors, err = projects.CheckCode(proj.ID, fmt.Sprintf(`
+			import HelloWorld from 0x%s
+			pub fun main(): Int { return HelloWorld.A }`, model.NewAddressFromIndex(0).ToFlowAddress().Hex()),
+			model.CodeKindScript,
+		)
+		require.NoError(t, err)
+		assert.Empty(t, programErrors)
+
+		// checking doesn't execute the transaction
+		scriptExe, err := projects.ExecuteScript(model.NewScriptExecution{
 			ProjectID: proj.ID,
-			Script:    script,
-			Arguments: []string{"{\"type\":\"Int\",\"value\":\"42\"}"},
-		}
+			Script: `
+				import "HelloWorld"
+				pub fun main(): Int { return HelloWorld.A }`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "5", scriptExe.Value)
+	})
 
-		exe, err := projects.ExecuteScript(scriptExe)
+	t.Run("checking errors with positions", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
 		require.NoError(t, err)
-		assert.Equal(t, exe.Value, "42")
+
+		programErrors, err := projects.CheckCode(proj.ID, `import "HelloWorld"
+pub fun main(): String {
+	return HelloWorld.A + missing
+}`,
+			model.CodeKindScript,
+		)
+		require.NoError(t, err)
+		require.Len(t, programErrors, 2)
+		require.NotNil(t, programErrors[0].StartPosition)
+		assert.Equal(t, 3, programErrors[0].StartPosition.Line)
 	})
 
-}
+	t.Run("parsing error", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
 
-func Benchmark_GetAccounts(b *testing.B) {
-	projects, _, proj, _ := newWithSeededProject()
+		programErrors, err := projects.CheckCode(proj.ID, `pub fun main() {`, model.CodeKindScript)
+		require.NoError(t, err)
+		require.Len(t, programErrors, 1)
+		assert.NotNil(t, programErrors[0].StartPosition)
+	})
 
-	addresses := make([]model.Address, 5)
-	for i := 0; i < 5; i++ {
-		addresses[i] = model.NewAddressFromIndex(i)
-	}
+	t.Run("invalid declarations for the kind", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
 
-	b.Run("get batch accounts", func(b *testing.B) {
-		for i := 0; i < b.N; i++ {
-			_, err := projects.GetAccounts(proj.ID, addresses)
-			assert.NoError(b, err)
-		}
+		programErrors, err := projects.CheckCode(proj.ID, `pub fun main() {}`, model.CodeKindContract)
+		require.NoError(t, err)
+		require.Len(t, programErrors, 1)
+		assert.Contains(t, programErrors[0].Message, "function declarations are not valid at the top-level")
+
+		programErrors, err = projects.CheckCode(proj.ID, contract, model.CodeKindContract)
+		require.NoError(t, err)
+		assert.Empty(t, programErrors)
+	})
+
+	t.Run("unresolved import", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		programErrors, err := projects.CheckCode(proj.ID, `
+			import Missing from 0x01
+			pub fun main() {}`,
+			model.CodeKindScript,
+		)
+		require.NoError(t, err)
+		require.Len(t, programErrors, 1)
+		assert.NotNil(t, programErrors[0].StartPosition)
 	})
 }
+
+func Test_RemoveContract(t *testing.T) {
+
+	script := `pub contract HelloWorld {}`
+
+	t.Run("remove contract and reset cache", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.DeployContract(proj.ID, address, script, nil, false)
+		require.NoError(t, err)
+
+		account, err := projects.RemoveContract(proj.ID, address, "HelloWorld")
+		require.NoError(t, err)
+		assert.NotContains(t, account.DeployedContracts, "HelloWorld")
+
+		// removal is replayed when the state is recreated
+		projects.flowKitCache.reset(proj.ID)
+
+		account, err = projects.GetAccount(proj.ID, address)
+		require.NoError(t, err)
+		assert.NotContains(t, account.DeployedContracts, "HelloWorld")
+
+		// removed contract can be deployed again
+		_, err = projects.DeployContract(proj.ID, address, script, nil, false)
+		require.NoError(t, err)
+
+		account, err = projects.GetAccount(proj.ID, address)
+		require.NoError(t, err)
+		assert.Contains(t, account.DeployedContracts, "HelloWorld")
+	})
+
+	t.Run("remove non-existent contract", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		_, err := projects.RemoveContract(proj.ID, model.NewAddressFromIndex(0), "HelloWorld")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "contract HelloWorld is not deployed to address 0x0000000000000005")
+	})
+}
+
+func Test_BlockControl(t *testing.T) {
+
+	const timestampScript = `pub fun main(): UFix64 { return getCurrentBlock().timestamp }`
+
+	t.Run("commit empty blocks and reset cache", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		block, err := projects.CommitBlocks(proj.ID, 3)
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+3, block.Height)
+
+		// committed blocks are replayed when the state is recreated
+		projects.flowKitCache.reset(proj.ID)
+
+		_, err = projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction {}`,
+		})
+		require.NoError(t, err)
+
+		fk, err = projects.load(proj.ID)
+		require.NoError(t, err)
+		height, err := fk.getLatestBlockHeight()
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+4, height)
+	})
+
+	t.Run("failed changes don't leave unrecorded blocks", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		// the failed deployment is committed in a block, which isn't recorded
+		_, err = projects.DeployContract(
+			proj.ID,
+			model.NewAddressFromIndex(0),
+			`pub contract Broken { init() { panic("broken") } }`,
+			nil,
+			false,
+		)
+		require.Error(t, err)
+
+		block, err := projects.CommitBlocks(proj.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+1, block.Height)
+	})
+
+	t.Run("invalid number of blocks", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		_, err := projects.CommitBlocks(proj.ID, 0)
+		require.Error(t, err)
+
+		_, err = projects.CommitBlocks(proj.ID, maxCommitBlocks+1)
+		require.Error(t, err)
+	})
+
+	t.Run("set and advance block timestamp", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		timestamp := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		block, err := projects.SetBlockTimestamp(proj.ID, timestamp)
+		require.NoError(t, err)
+		assert.Equal(t, timestamp, block.Timestamp)
+
+		exe, err := projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script:    timestampScript,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1893456000.00000000", exe.Value)
+
+		block, err = projects.AdvanceBlockTimestamp(proj.ID, 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, timestamp.Add(24*time.Hour), block.Timestamp)
+
+		// later blocks continue from the advanced timestamp
+		_, err = projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction {}`,
+		})
+		require.NoError(t, err)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		latest, err := fk.getLatestBlock()
+		require.NoError(t, err)
+		assert.False(t, latest.Timestamp.Before(block.Timestamp))
+
+		// timestamps are replayed when the state is recreated
+		projects.flowKitCache.reset(proj.ID)
+
+		exe, err = projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script:    timestampScript,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1893542400.00000000", exe.Value)
+	})
+
+	t.Run("set block timestamp earlier than latest block", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		timestamp := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		_, err := projects.SetBlockTimestamp(proj.ID, timestamp)
+		require.NoError(t, err)
+
+		_, err = projects.SetBlockTimestamp(proj.ID, timestamp.Add(-time.Second))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can't be earlier than the latest block timestamp")
+	})
+
+	t.Run("block timestamps are replayed", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		_, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction {}`,
+		})
+		require.NoError(t, err)
+
+		_, err = projects.SetBlockTimestamp(proj.ID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		_, err = projects.CommitBlocks(proj.ID, 2)
+		require.NoError(t, err)
+
+		_, err = projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction {}`,
+		})
+		require.NoError(t, err)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		blocks := blockTimestamps(t, fk.(*flowKit))
+
+		projects.flowKitCache.reset(proj.ID)
+
+		fk, err = projects.load(proj.ID)
+		require.NoError(t, err)
+		replayed := blockTimestamps(t, fk.(*flowKit))
+
+		require.Len(t, replayed, len(blocks))
+		for height, timestamp := range blocks {
+			assert.True(t, timestamp.Equal(replayed[height]), "block %d timestamp changed", height)
+		}
+
+		// the next blocks continue from the moved time
+		block, err := projects.CommitBlocks(proj.ID, 1)
+		require.NoError(t, err)
+		assert.False(t, block.Timestamp.Before(blocks[len(blocks)-1]))
+		assert.True(t, block.Timestamp.After(time.Now().Add(59*time.Minute)))
+	})
+
+	t.Run("advance by non-positive duration", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		_, err := projects.AdvanceBlockTimestamp(proj.ID, 0)
+		require.Error(t, err)
+	})
+}
+
+func Test_CreateAccount(t *testing.T) {
+
+	t.Run("create account and reset cache", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		account, err := projects.CreateAccount(proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, model.NewAddressFromIndex(accountsNumber), account.Address)
+
+		accounts, err := projects.GetAllAccounts(proj.ID)
+		require.NoError(t, err)
+		require.Len(t, accounts, accountsNumber+1)
+
+		// account creation is replayed when the state is recreated
+		projects.flowKitCache.reset(proj.ID)
+
+		accounts, err = projects.GetAllAccounts(proj.ID)
+		require.NoError(t, err)
+		require.Len(t, accounts, accountsNumber+1)
+		assert.Equal(t, account.Address, accounts[accountsNumber].Address)
+
+		// created account can sign transactions
+		exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction { prepare (signer: AuthAccount) {} }`,
+			Signers:   []model.Address{account.Address},
+		})
+		require.NoError(t, err)
+		require.Empty(t, exe.Errors)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		height, err := fk.getLatestBlockHeight()
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+2, height)
+	})
+
+	t.Run("init accounts", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		err := projects.InitAccounts(proj.ID, accountsNumber+3)
+		require.NoError(t, err)
+
+		// accounts already exist
+		err = projects.InitAccounts(proj.ID, accountsNumber+3)
+		require.NoError(t, err)
+
+		projects.flowKitCache.reset(proj.ID)
+
+		accounts, err := projects.GetAllAccounts(proj.ID)
+		require.NoError(t, err)
+		assert.Len(t, accounts, accountsNumber+3)
+	})
+
+	t.Run("rollback removes created account", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		_, err = projects.CreateAccount(proj.ID)
+		require.NoError(t, err)
+
+		err = projects.Rollback(proj.ID, fk.initBlockHeight())
+		require.NoError(t, err)
+
+		accounts, err := projects.GetAllAccounts(proj.ID)
+		require.NoError(t, err)
+		assert.Len(t, accounts, accountsNumber)
+	})
+
+	t.Run("initial accounts are kept on rollback and reset", func(t *testing.T) {
+		projects, store := newProjects()
+		proj, files := projectSeed()
+		proj.NumberOfAccounts = accountsNumber + 2
+		err := store.CreateProject(proj, files)
+		require.NoError(t, err)
+
+		err = projects.InitAccounts(proj.ID, proj.NumberOfAccounts)
+		require.NoError(t, err)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		genesisHeight := fk.initBlockHeight() + 2
+
+		_, err = projects.CreateAccount(proj.ID)
+		require.NoError(t, err)
+
+		err = projects.Rollback(proj.ID, genesisHeight-1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("block height must be between %d and", genesisHeight))
+
+		err = projects.Rollback(proj.ID, genesisHeight)
+		require.NoError(t, err)
+
+		accounts, err := projects.GetAllAccounts(proj.ID)
+		require.NoError(t, err)
+		assert.Len(t, accounts, proj.NumberOfAccounts)
+
+		_, err = projects.CreateAccount(proj.ID)
+		require.NoError(t, err)
+
+		err = projects.Reset(proj.ID)
+		require.NoError(t, err)
+
+		accounts, err = projects.GetAllAccounts(proj.ID)
+		require.NoError(t, err)
+		assert.Len(t, accounts, proj.NumberOfAccounts)
+	})
+
+	t.Run("maximum accounts limit", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		projects.maxAccounts = accountsNumber + 1
+
+		_, err := projects.CreateAccount(proj.ID)
+		require.NoError(t, err)
+
+		_, err = projects.CreateAccount(proj.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "maximum number of 6 accounts reached")
+	})
+}
+
+func Test_FundAccount(t *testing.T) {
+
+	t.Run("fund account and reset cache", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		before, err := projects.GetAccount(proj.ID, address)
+		require.NoError(t, err)
+
+		account, err := projects.FundAccount(proj.ID, address, "10.5")
+		require.NoError(t, err)
+
+		initial, err := cadence.NewUFix64(before.Balance)
+		require.NoError(t, err)
+		funded, err := cadence.NewUFix64(account.Balance)
+		require.NoError(t, err)
+		assert.Equal(t, initial+cadence.UFix64(10_5000_0000), funded)
+		assert.NotEmpty(t, account.AvailableBalance)
+
+		// funding is replayed when the state is recreated
+		projects.flowKitCache.reset(proj.ID)
+
+		account, err = projects.GetAccount(proj.ID, address)
+		require.NoError(t, err)
+		assert.Equal(t, funded.String(), account.Balance)
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.FundAccount(proj.ID, address, "-1.0")
+		assert.Error(t, err)
+
+		_, err = projects.FundAccount(proj.ID, address, "0.0")
+		assert.Error(t, err)
+	})
+
+	t.Run("non-existent account", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		_, err := projects.FundAccount(proj.ID, model.NewAddressFromIndex(100), "1.0")
+		assert.Error(t, err)
+	})
+}
+
+func Test_ScriptExecution(t *testing.T) {
+
+	t.Run("single script execution", func(t *testing.T) {
+		projects, store, proj, _ := newWithSeededProject()
+
+		script := `pub fun main(): Int { 
+			log("purpose")
+			log("haha")
+			log("test")
+			return 42 
+		}`
+
+		scriptExe := model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script:    script,
+			Arguments: nil,
+		}
+
+		exe, err := projects.ExecuteScript(scriptExe)
+		require.NoError(t, err)
+		assert.Len(t, exe.Errors, 0)
+		assert.Equal(t, `"purpose"`, exe.Logs[0])
+		assert.Equal(t, "42", exe.Value)
+		assert.Equal(t, proj.ID, exe.ProjectID)
+
+		var dbScripts []*model.ScriptExecution
+		err = store.GetScriptExecutionsForProject(proj.ID, &dbScripts)
+		require.NoError(t, err)
+
+		require.Len(t, dbScripts, 1)
+		assert.Equal(t, dbScripts[0].Script, script)
+	})
+
+	t.Run("concurrent script executions", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		const executions = 10
+
+		var wg sync.WaitGroup
+		logs := make([][]string, executions)
+		errs := make([]error, executions)
+		for i := 0; i < executions; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				exe, err := projects.ExecuteScript(model.NewScriptExecution{
+					ProjectID: proj.ID,
+					Script: fmt.Sprintf(`pub fun main() {
+						log("start %[1]d")
+						log("end %[1]d")
+					}`, i),
+				})
+				errs[i] = err
+				if err == nil {
+					logs[i] = exe.Logs
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		for i := 0; i < executions; i++ {
+			require.NoError(t, errs[i])
+			assert.Equal(t, []string{fmt.Sprintf(`"start %d"`, i), fmt.Sprintf(`"end %d"`, i)}, logs[i])
+		}
+	})
+
+	t.Run("script execution importing deployed contract, with cache reset", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		scriptA := `
+			pub contract HelloWorldA {
+				pub var A: String
+				pub init() { self.A = "HelloWorldA" }
+			}`
+
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), scriptA, nil, false)
+		require.NoError(t, err)
+
+		script := `
+			import HelloWorldA from 0x05
+			pub fun main(): String { 
+				return HelloWorldA.A
+			}`
+
+		scriptExe := model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script:    script,
+			Arguments: nil,
+		}
+
+		exe, err := projects.ExecuteScript(scriptExe)
+		require.NoError(t, err)
+		assert.Equal(t, "\"HelloWorldA\"", exe.Value)
+	})
+
+	t.Run("script with arguments", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+
+		script := `pub fun main(a: Int): Int { 
+			return a
+		}`
+
+		scriptExe := model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script:    script,
+			Arguments: []string{"{\"type\":\"Int\",\"value\":\"42\"}"},
+		}
+
+		exe, err := projects.ExecuteScript(scriptExe)
+		require.NoError(t, err)
+		assert.Equal(t, exe.Value, "42")
+	})
+
+	t.Run("script execution at block height", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		projects.snapshotInterval = 1
+
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), `
+			pub contract Counter {
+				pub var count: Int
+				pub fun increment() { self.count = self.count + 1 }
+				pub init() { self.count = 0 }
+			}`, nil, false)
+		require.NoError(t, err)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		height, err := fk.getLatestBlockHeight()
+		require.NoError(t, err)
+
+		_, err = projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import Counter from 0x05
+				transaction { execute { Counter.increment() } }`,
+		})
+		require.NoError(t, err)
+
+		script := `
+			import Counter from 0x05
+			pub fun main(): Int { return Counter.count }`
+
+		exe, err := projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID:   proj.ID,
+			Script:      script,
+			BlockHeight: &height,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "0", exe.Value)
+		assert.Equal(t, height, *exe.BlockHeight)
+
+		exe, err = projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script:    script,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1", exe.Value)
+		assert.Nil(t, exe.BlockHeight)
+
+		// historical state is available after restoring the project from a snapshot
+		projects.flowKitCache.reset(proj.ID)
+
+		exe, err = projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID:   proj.ID,
+			Script:      script,
+			BlockHeight: &height,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "0", exe.Value)
+
+		futureHeight := height + 2
+		_, err = projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID:   proj.ID,
+			Script:      script,
+			BlockHeight: &futureHeight,
+		})
+		require.Error(t, err)
+	})
+}
+
+func Benchmark_GetAccounts(b *testing.B) {
+	projects, _, proj, _ := newWithSeededProject()
+
+	addresses := make([]model.Address, 5)
+	for i := 0; i < 5; i++ {
+		addresses[i] = model.NewAddressFromIndex(i)
+	}
+
+	b.Run("get batch accounts", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := projects.GetAccounts(proj.ID, addresses)
+			assert.NoError(b, err)
+		}
+	})
+}
+
+func Test_AccountStorage(t *testing.T) {
+
+	t.Run("storage page", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				transaction {
+					prepare(signer: AuthAccount) {
+						signer.save(1, to: /storage/a)
+						signer.save("b", to: /storage/b)
+						signer.save([3], to: /storage/c)
+						signer.link<&Int>(/public/a, target: /storage/a)
+					}
+				}`,
+			Signers: []model.Address{address},
+		})
+		require.NoError(t, err)
+
+		storage, err := projects.GetAccountStorage(proj.ID, address, model.StorageFilter{
+			Offset: 1,
+			Limit:  2,
+			Depth:  model.DefaultStorageDepth,
+		})
+		require.NoError(t, err)
+
+		// the account has the paths of the initial state too, so the page is compared to all the paths
+		all, err := projects.GetAccountStorage(proj.ID, address, model.StorageFilter{
+			Limit: model.MaxStorageLimit,
+			Depth: model.DefaultStorageDepth,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, all.TotalCount, storage.TotalCount)
+		assert.Equal(t, all.Items[1:3], storage.Items)
+
+		items := make(map[string]*model.StorageItem)
+		for _, item := range all.Items {
+			items[item.Path] = item
+		}
+		assert.Equal(t, "1", *items["/storage/a"].Value.Value)
+		assert.Equal(t, "/storage/a", *items["/public/a"].Target)
+	})
+
+	t.Run("offset after the last path", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		storage, err := projects.GetAccountStorage(proj.ID, address, model.StorageFilter{
+			Offset: 1000,
+			Limit:  model.DefaultStorageLimit,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, storage.Items)
+		assert.Greater(t, storage.TotalCount, 0)
+	})
+
+	t.Run("storage sizes", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				transaction {
+					prepare(signer: AuthAccount) {
+						var numbers: [Int] = []
+						var i = 0
+						while i < 1000 {
+							numbers.append(i)
+							i = i + 1
+						}
+						signer.save(1, to: /storage/small)
+						signer.save(numbers, to: /storage/large)
+					}
+				}`,
+			Signers: []model.Address{address},
+		})
+		require.NoError(t, err)
+
+		storage, err := projects.GetAccountStorage(proj.ID, address, model.StorageFilter{
+			Limit: model.MaxStorageLimit,
+		})
+		require.NoError(t, err)
+
+		sizes := make(map[string]int)
+		for _, item := range storage.Items {
+			sizes[item.Path] = item.Size
+		}
+
+		assert.Greater(t, sizes["/storage/small"], 0)
+		assert.Less(t, sizes["/storage/small"], 100)
+		// the array is stored in its own registers, which are larger than the encoded elements
+		assert.Greater(t, sizes["/storage/large"], 3000)
+	})
+}
+
+func Test_AccountStorageDiff(t *testing.T) {
+
+	t.Run("storage diff between block heights", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				transaction {
+					prepare(signer: AuthAccount) {
+						signer.save(1, to: /storage/modified)
+						signer.save(2, to: /storage/removed)
+					}
+				}`,
+			Signers: []model.Address{address},
+		})
+		require.NoError(t, err)
+
+		includeDiff := true
+		exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				transaction {
+					prepare(signer: AuthAccount) {
+						signer.load<Int>(from: /storage/modified)
+						signer.save(3, to: /storage/modified)
+						signer.load<Int>(from: /storage/removed)
+						signer.save(4, to: /storage/added)
+					}
+				}`,
+			Signers:            []model.Address{address},
+			IncludeStorageDiff: &includeDiff,
+		})
+		require.NoError(t, err)
+		require.Len(t, exe.StorageDiffs, 1)
+
+		diff := exe.StorageDiffs[0]
+		assert.Equal(t, address, diff.Address)
+		assert.Equal(t, exe.BlockHeight-1, diff.FromHeight)
+		assert.Equal(t, exe.BlockHeight, diff.ToHeight)
+		require.Len(t, diff.Changes, 3)
+
+		added := diff.Changes[0]
+		assert.Equal(t, "/storage/added", added.Path)
+		assert.Equal(t, model.StorageChangeKindAdded, added.Kind)
+		assert.Nil(t, added.Before)
+		assert.Equal(t, "4", *added.After.Value.Value)
+
+		modified := diff.Changes[1]
+		assert.Equal(t, "/storage/modified", modified.Path)
+		assert.Equal(t, model.StorageChangeKindModified, modified.Kind)
+		assert.Equal(t, "1", *modified.Before.Value.Value)
+		assert.Equal(t, "3", *modified.After.Value.Value)
+
+		removed := diff.Changes[2]
+		assert.Equal(t, "/storage/removed", removed.Path)
+		assert.Equal(t, model.StorageChangeKindRemoved, removed.Kind)
+		assert.Equal(t, "2", *removed.Before.Value.Value)
+		assert.Nil(t, removed.After)
+
+		// the whole history compared to the initial state contains only the added paths
+		diff, err = projects.GetAccountStorageDiff(proj.ID, address, exe.BlockHeight-2, exe.BlockHeight)
+		require.NoError(t, err)
+		require.Len(t, diff.Changes, 2)
+		assert.Equal(t, model.StorageChangeKindAdded, diff.Changes[0].Kind)
+		assert.Equal(t, model.StorageChangeKindAdded, diff.Changes[1].Kind)
+	})
+
+	t.Run("invalid block heights", func(t *testing.T) {
+		projects, _, proj, _ := newWithSeededProject()
+		address := model.NewAddressFromIndex(0)
+
+		_, err := projects.GetAccountStorageDiff(proj.ID, address, 3, 2)
+		assert.Error(t, err)
+
+		_, err = projects.GetAccountStorageDiff(proj.ID, address, 0, 100)
+		assert.Error(t, err)
+	})
+}
+
+func Test_Snapshots(t *testing.T) {
+
+	t.Run("load project from snapshot after cache reset", func(t *testing.T) {
+		projects, store, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+		projects.snapshotInterval = 2
+
+		contract := `
+			pub contract HelloWorld {
+				pub var A: String
+				pub init() { self.A = "HelloWorld" }
+			}`
+
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contract, nil, false)
+		require.NoError(t, err)
+
+		tx := model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import HelloWorld from 0x05
+				transaction {
+					prepare (signer: AuthAccount) {}
+					execute {
+						log(HelloWorld.A)
+					}
+				}`,
+			Signers: []model.Address{model.NewAddressFromIndex(1)},
+		}
+
+		for i := 0; i < 2; i++ {
+			exe, err := projects.ExecuteTransaction(tx)
+			require.NoError(t, err)
+			require.Len(t, exe.Errors, 0)
+		}
+
+		var snapshot model.Snapshot
+		err = store.GetLatestSnapshot(proj.ID, &snapshot)
+		require.NoError(t, err)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+2, snapshot.BlockHeight)
+
+		projects.flowKitCache.reset(proj.ID)
+
+		fk, err = projects.load(proj.ID)
+		require.NoError(t, err)
+
+		height, err := fk.getLatestBlockHeight()
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+3, height)
+
+		exe, err := projects.ExecuteTransaction(tx)
+		require.NoError(t, err)
+		require.Len(t, exe.Errors, 0)
+		assert.Contains(t, exe.Logs[0], "HelloWorld")
+	})
+
+	t.Run("contract redeploy removes stale snapshots", func(t *testing.T) {
+		projects, store, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+		projects.snapshotInterval = 1
+
+		contractA := `
+			pub contract HelloWorld {
+				pub var A: String
+				pub init() { self.A = "HelloWorldA" }
+			}`
+
+		contractB := `
+			pub contract HelloWorld {
+				pub var B: String
+				pub init() { self.B = "HelloWorldB" }
+			}`
+
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contractA, nil, false)
+		require.NoError(t, err)
+
+		_, err = projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), contractB, nil, false)
+		require.NoError(t, err)
+
+		var snapshot model.Snapshot
+		err = store.GetLatestSnapshot(proj.ID, &snapshot)
+		require.NoError(t, err)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+1, snapshot.BlockHeight)
+
+		projects.flowKitCache.reset(proj.ID)
+
+		result, err := projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import HelloWorld from 0x05
+				pub fun main(): String { return HelloWorld.B }`,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 0)
+		assert.Equal(t, `"HelloWorldB"`, result.Value)
+	})
+
+	t.Run("zero interval disables snapshots", func(t *testing.T) {
+		projects, store, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+		projects.snapshotInterval = 0
+
+		for i := 0; i < 2; i++ {
+			exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+				ProjectID: proj.ID,
+				Script:    `transaction {}`,
+			})
+			require.NoError(t, err)
+			require.Len(t, exe.Errors, 0)
+		}
+
+		projects.flowKitCache.reset(proj.ID)
+		_, err = projects.load(proj.ID)
+		require.NoError(t, err)
+
+		var snapshot model.Snapshot
+		err = store.GetLatestSnapshot(proj.ID, &snapshot)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("state before the snapshot is replayed", func(t *testing.T) {
+		projects, _, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+		projects.snapshotInterval = 2
+
+		for i := 1; i <= 4; i++ {
+			exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+				ProjectID: proj.ID,
+				Script: `
+					transaction(n: Int) {
+						prepare(signer: AuthAccount) {
+							signer.load<Int>(from: /storage/n)
+							signer.save(n, to: /storage/n)
+						}
+					}`,
+				Signers:   []model.Address{model.NewAddressFromIndex(0)},
+				Arguments: []string{fmt.Sprintf(`{"type":"Int","value":"%d"}`, i)},
+			})
+			require.NoError(t, err)
+			require.Len(t, exe.Errors, 0)
+		}
+
+		projects.flowKitCache.reset(proj.ID)
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		initHeight := fk.initBlockHeight()
+		assert.Equal(t, initHeight+4, fk.historyHeight())
+
+		script := `pub fun main(): Int? { return getAuthAccount(0x05).copy<Int>(from: /storage/n) }`
+
+		height := initHeight + 1
+		exe, err := projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID:   proj.ID,
+			Script:      script,
+			BlockHeight: &height,
+		})
+		require.NoError(t, err)
+		require.Len(t, exe.Errors, 0)
+		assert.Equal(t, "1", exe.Value)
+
+		exe, err = projects.ExecuteScript(model.NewScriptExecution{
+			ProjectID: proj.ID,
+			Script:    script,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "4", exe.Value)
+	})
+}
+
+func Test_ExecuteBatch(t *testing.T) {
+
+	const contract = `
+		pub contract HelloWorld {
+			pub var A: String
+			pub init() { self.A = "HelloWorld" }
+		}`
+
+	const transaction = `
+		import HelloWorld from 0x05
+		transaction {
+			prepare (signer: AuthAccount) {}
+			execute {
+				log(HelloWorld.A)
+			}
+		}`
+
+	t.Run("successful batch execution", func(t *testing.T) {
+		projects, store, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+
+		results, err := projects.ExecuteBatch(model.NewExecutionBatch{
+			ProjectID: proj.ID,
+			Steps: []*model.ExecutionBatchStep{
+				{ContractDeployment: &model.BatchContractDeployment{
+					Script:  contract,
+					Address: model.NewAddressFromIndex(0),
+				}},
+				{TransactionExecution: &model.BatchTransactionExecution{
+					Script:  transaction,
+					Signers: []model.Address{model.NewAddressFromIndex(1)},
+				}},
+				{ScriptExecution: &model.BatchScriptExecution{
+					Script: `
+						import HelloWorld from 0x05
+						pub fun main(): String { return HelloWorld.A }`,
+				}},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.Equal(t, "HelloWorld", results[0].ContractDeployment.Title)
+		assert.Contains(t, results[1].TransactionExecution.Logs[0], "HelloWorld")
+		assert.Equal(t, `"HelloWorld"`, results[2].ScriptExecution.Value)
+
+		var deployments []*model.ContractDeployment
+		err = store.GetContractDeploymentsForProject(proj.ID, &deployments)
+		require.NoError(t, err)
+		assert.Len(t, deployments, 1)
+
+		var executions []*model.TransactionExecution
+		err = store.GetTransactionExecutionsForProject(proj.ID, &executions)
+		require.NoError(t, err)
+		assert.Len(t, executions, 1)
+
+		projects.flowKitCache.reset(proj.ID)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		height, err := fk.getLatestBlockHeight()
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight()+2, height)
+	})
+
+	t.Run("failed batch execution is rolled back", func(t *testing.T) {
+		projects, store, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+
+		_, err = projects.ExecuteBatch(model.NewExecutionBatch{
+			ProjectID: proj.ID,
+			Steps: []*model.ExecutionBatchStep{
+				{ContractDeployment: &model.BatchContractDeployment{
+					Script:  contract,
+					Address: model.NewAddressFromIndex(0),
+				}},
+				{TransactionExecution: &model.BatchTransactionExecution{
+					Script: `transaction { execute { panic("oh no") } }`,
+				}},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "batch step 1 failed")
+
+		var deployments []*model.ContractDeployment
+		err = store.GetContractDeploymentsForProject(proj.ID, &deployments)
+		require.NoError(t, err)
+		assert.Len(t, deployments, 0)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+
+		height, err := fk.getLatestBlockHeight()
+		require.NoError(t, err)
+		assert.Equal(t, fk.initBlockHeight(), height)
+
+		account, err := projects.GetAccount(proj.ID, model.NewAddressFromIndex(0))
+		require.NoError(t, err)
+		assert.Empty(t, account.DeployedContracts)
+	})
+
+	t.Run("invalid batch step", func(t *testing.T) {
+		projects, _, proj, err := newWithSeededProject()
+		require.NoError(t, err)
+
+		_, err = projects.ExecuteBatch(model.NewExecutionBatch{
+			ProjectID: proj.ID,
+			Steps: []*model.ExecutionBatchStep{
+				{
+					TransactionExecution: &model.BatchTransactionExecution{Script: "transaction {}"},
+					ScriptExecution:      &model.BatchScriptExecution{Script: "pub fun main() {}"},
+				},
+			},
+		})
+		require.Error(t, err)
+	})
+}
+
+func Test_EmulatorConfig(t *testing.T) {
+
+	newProjectWithConfig := func(t *testing.T, config model.EmulatorConfig) (*Projects, *model.Project) {
+		projects, store := newProjects()
+		proj, files := projectSeed()
+		proj.EmulatorConfig = config
+		err := store.CreateProject(proj, files)
+		require.NoError(t, err)
+
+		return projects, proj
+	}
+
+	t.Run("fees and validation enabled", func(t *testing.T) {
+		projects, proj := newProjectWithConfig(t, model.EmulatorConfig{
+			TransactionFeesEnabled:       true,
+			TransactionValidationEnabled: true,
+			ComputationLimit:             9999,
+		})
+
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), `
+			pub contract HelloWorld {
+				pub var A: String
+				pub init() { self.A = "HelloWorld" }
+			}`, nil, false)
+		require.NoError(t, err)
+
+		exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				import HelloWorld from 0x05
+				transaction {
+					prepare (signer: AuthAccount) {}
+					execute { log(HelloWorld.A) }
+				}`,
+			Signers: []model.Address{model.NewAddressFromIndex(1)},
+		})
+		require.NoError(t, err)
+		require.Empty(t, exe.Errors)
+
+		fees := false
+		for _, event := range exe.Events {
+			if event.Type == "A.0000000000000004.FlowFees.FeesDeducted" {
+				fees = true
+			}
+		}
+		assert.True(t, fees)
+		assert.NotEqual(t, "0.00000000", exe.Fee)
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		assert.True(t, fk.(*flowKit).config.TransactionFeesEnabled)
+	})
+
+	t.Run("storage limit enabled", func(t *testing.T) {
+		projects, proj := newProjectWithConfig(t, model.EmulatorConfig{
+			StorageLimitEnabled:    true,
+			TransactionFeesEnabled: true,
+		})
+
+		// the minimum account balance doesn't cover storage of the contract and the fees
+		_, err := projects.DeployContract(proj.ID, model.NewAddressFromIndex(0), `
+			pub contract HelloWorld {
+				pub var A: String
+				pub init() { self.A = "HelloWorld" }
+			}`, nil, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "storage limit check failed")
+	})
+
+	t.Run("computation limit", func(t *testing.T) {
+		projects, proj := newProjectWithConfig(t, model.EmulatorConfig{ComputationLimit: 50})
+
+		exe, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script: `
+				transaction {
+					execute {
+						var i = 0
+						while i < 100_000 {
+							i = i + 1
+						}
+					}
+				}`,
+		})
+		require.NoError(t, err)
+		require.Len(t, exe.Errors, 1)
+		assert.Contains(t, exe.Errors[0].Message, "computation exceeds limit (50)")
+	})
+
+	t.Run("configuration change reloads emulator", func(t *testing.T) {
+		projects, proj := newProjectWithConfig(t, model.DefaultEmulatorConfig())
+
+		fk, err := projects.load(proj.ID)
+		require.NoError(t, err)
+		assert.False(t, fk.(*flowKit).config.TransactionFeesEnabled)
+
+		enabled := true
+		var updated model.Project
+		err = projects.UpdateProject(model.UpdateProject{
+			ID:             proj.ID,
+			EmulatorConfig: &model.EmulatorConfigInput{TransactionFeesEnabled: &enabled},
+		}, &updated)
+		require.NoError(t, err)
+		assert.True(t, updated.EmulatorConfig.TransactionFeesEnabled)
+		assert.Equal(t, model.DefaultComputationLimit, updated.EmulatorConfig.ComputationLimit)
+
+		fk, err = projects.load(proj.ID)
+		require.NoError(t, err)
+		assert.True(t, fk.(*flowKit).config.TransactionFeesEnabled)
+	})
+
+	t.Run("configuration change requires reset of used project", func(t *testing.T) {
+		projects, proj := newProjectWithConfig(t, model.DefaultEmulatorConfig())
+
+		_, err := projects.ExecuteTransaction(model.NewTransactionExecution{
+			ProjectID: proj.ID,
+			Script:    `transaction {}`,
+		})
+		require.NoError(t, err)
+
+		enabled := true
+		input := model.UpdateProject{
+			ID:             proj.ID,
+			EmulatorConfig: &model.EmulatorConfigInput{StorageLimitEnabled: &enabled},
+		}
+
+		var updated model.Project
+		err = projects.UpdateProject(input, &updated)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reset the project first")
+
+		var executions []*model.TransactionExecution
+		err = projects.store.GetTransactionExecutionsForProject(proj.ID, &executions)
+		require.NoError(t, err)
+		assert.Len(t, executions, 1)
+
+		// the same configuration can still be provided
+		err = projects.UpdateProject(model.UpdateProject{
+			ID:             proj.ID,
+			EmulatorConfig: &model.EmulatorConfigInput{StorageLimitEnabled: new(bool)},
+		}, &updated)
+		require.NoError(t, err)
+
+		err = projects.Reset(proj.ID)
+		require.NoError(t, err)
+
+		err = projects.UpdateProject(input, &updated)
+		require.NoError(t, err)
+		assert.True(t, updated.EmulatorConfig.StorageLimitEnabled)
+	})
+}
+
+// blockTimestamps returns the timestamps of the blocks committed in the project, indexed from the first one.
+func blockTimestamps(t *testing.T, fk *flowKit) []time.Time {
+	height, err := fk.getLatestBlockHeight()
+	require.NoError(t, err)
+
+	var timestamps []time.Time
+	for h := fk.initBlockHeight() + 1; h <= height; h++ {
+		block, err := fk.gateway.GetBlockByHeight(uint64(h))
+		require.NoError(t, err)
+		timestamps = append(timestamps, block.Timestamp)
+	}
+	return timestamps
+}
@@ -0,0 +1,120 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+// entryPoint is the declaration executed with the arguments: the transaction, the main function of a script
+// or the initializer of a contract.
+type entryPoint struct {
+	kind       model.CodeKind
+	parameters []*ast.Parameter
+	signers    int
+	returnType *ast.TypeAnnotation
+}
+
+// parseEntryPoint parses the code and finds its entry point.
+func parseEntryPoint(code string) (*entryPoint, error) {
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction := program.SoleTransactionDeclaration(); transaction != nil {
+		entry := &entryPoint{
+			kind:       model.CodeKindTransaction,
+			parameters: parameters(transaction.ParameterList),
+		}
+		if transaction.Prepare != nil {
+			entry.signers = len(parameters(transaction.Prepare.FunctionDeclaration.ParameterList))
+		}
+		return entry, nil
+	}
+
+	if contract := program.SoleContractDeclaration(); contract != nil {
+		entry := &entryPoint{
+			kind: model.CodeKindContract,
+		}
+		if initializers := contract.Members.Initializers(); len(initializers) > 0 {
+			entry.parameters = parameters(initializers[0].FunctionDeclaration.ParameterList)
+		}
+		return entry, nil
+	}
+
+	if program.SoleContractInterfaceDeclaration() != nil {
+		return &entryPoint{kind: model.CodeKindContract}, nil
+	}
+
+	for _, function := range program.FunctionDeclarations() {
+		if function.Identifier.Identifier == "main" {
+			return &entryPoint{
+				kind:       model.CodeKindScript,
+				parameters: parameters(function.ParameterList),
+				returnType: function.ReturnTypeAnnotation,
+			}, nil
+		}
+	}
+
+	return nil, errors.New("the code must declare a transaction, a main function or a contract")
+}
+
+func parameters(parameterList *ast.ParameterList) []*ast.Parameter {
+	if parameterList == nil {
+		return nil
+	}
+	return parameterList.Parameters
+}
+
+// ParseProgramSignature returns the parameters of the code entry point, the number of the transaction signers
+// and the return type of a script.
+func ParseProgramSignature(code string) (*model.ProgramSignature, error) {
+	entry, err := parseEntryPoint(code)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := &model.ProgramSignature{
+		Kind:       entry.kind,
+		Parameters: make([]*model.ProgramParameter, len(entry.parameters)),
+		Signers:    entry.signers,
+	}
+
+	for i, parameter := range entry.parameters {
+		signature.Parameters[i] = &model.ProgramParameter{
+			Name: parameter.Identifier.Identifier,
+			Type: parameter.TypeAnnotation.String(),
+		}
+	}
+
+	if entry.kind == model.CodeKindScript {
+		returnType := "Void"
+		if entry.returnType != nil {
+			returnType = entry.returnType.String()
+		}
+		signature.ReturnType = &returnType
+	}
+
+	return signature, nil
+}
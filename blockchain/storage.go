@@ -56,3 +56,55 @@ pub fun main(address: Address) : AnyStruct{
 	})
 	return res
 }`
+
+// StoragePaths lists the account paths with the types of their values, without reading the values.
+const StoragePaths = `
+pub fun main(address: Address) : AnyStruct{
+
+	var res :  [{String:AnyStruct}] = []
+	let account = getAuthAccount(address)
+
+	account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+		res.append({"path" : path, "type" : type.identifier})
+		return true
+	})
+
+	account.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+		res.append({"path" : path, "type" : type.identifier})
+		return true
+	})
+
+	account.forEachPrivate(fun (path: PrivatePath, type: Type): Bool {
+		res.append({"path" : path, "type" : type.identifier})
+		return true
+	})
+	return res
+}`
+
+// StorageValues reads the values of the provided account paths, the types are taken from the listed paths.
+const StorageValues = `
+pub fun main(address: Address, paths: [Path]) : AnyStruct{
+
+	var res :  [{String:AnyStruct}] = []
+	let account = getAuthAccount(address)
+
+	for path in paths {
+		if let storagePath = path as? StoragePath {
+			let type = account.type(at: storagePath)!
+			res.append(
+			{
+				"path" : path,
+				"value":  type.isSubtype(of: Type<AnyStruct>()) ?
+								account.borrow<&AnyStruct>(from: storagePath)! as AnyStruct
+								: account.borrow<&AnyResource>(from: storagePath)! as AnyStruct
+			})
+		} else if let capabilityPath = path as? CapabilityPath {
+			res.append(
+			{
+				"path" : path,
+				"value":  account.getLinkTarget(capabilityPath)
+			})
+		}
+	}
+	return res
+}`
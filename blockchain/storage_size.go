@@ -0,0 +1,159 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/onflow/atree"
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go/fvm/environment"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	flowgo "github.com/onflow/flow-go/model/flow"
+	"github.com/pkg/errors"
+)
+
+// storageLedger is a read-only ledger of the emulator registers at a block height.
+type storageLedger struct {
+	snapshot snapshot.StorageSnapshot
+}
+
+var _ atree.Ledger = &storageLedger{}
+
+func (l *storageLedger) GetValue(owner, key []byte) ([]byte, error) {
+	return l.snapshot.Get(flowgo.NewRegisterID(string(owner), string(key)))
+}
+
+func (l *storageLedger) SetValue(_, _, _ []byte) error {
+	return errors.New("account storage is read only")
+}
+
+func (l *storageLedger) ValueExists(owner, key []byte) (bool, error) {
+	value, err := l.GetValue(owner, key)
+	return len(value) > 0, err
+}
+
+func (l *storageLedger) AllocateStorageIndex(_ []byte) (atree.StorageIndex, error) {
+	return atree.StorageIndex{}, errors.New("account storage is read only")
+}
+
+// accountStorageSizes returns the bytes used by the values of the account paths, keyed by the path.
+//
+// A value stored in its own slabs uses the size of the registers holding them, the same way they are counted
+// in the account storage used, and a value stored inline in the account storage map uses its encoded size.
+func accountStorageSizes(
+	ledger *storageLedger,
+	address flow.Address,
+	paths []cadence.Path,
+) (sizes map[string]int, err error) {
+	// the storage panics if it fails to read or decode the registers
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to read account storage: %v", r)
+		}
+	}()
+
+	storage := runtime.NewStorage(ledger, nil)
+
+	sizes = make(map[string]int, len(paths))
+	for _, path := range paths {
+		size, err := storedValueSize(storage, ledger, common.Address(address), path)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to get storage size of %s", path))
+		}
+		sizes[path.String()] = size
+	}
+
+	return sizes, nil
+}
+
+func storedValueSize(storage *runtime.Storage, ledger *storageLedger, address common.Address, path cadence.Path) (int, error) {
+	storageMap := storage.GetStorageMap(address, path.Domain.Identifier(), false)
+	if storageMap == nil {
+		return 0, nil
+	}
+
+	// the storage map only returns the decoded values, so the storable is read from the underlying map
+	orderedMap, err := atree.NewMapWithRootID(storage, storageMap.StorageID(), atree.NewDefaultDigesterBuilder())
+	if err != nil {
+		return 0, err
+	}
+
+	key := interpreter.StringStorageMapKey(path.Identifier)
+	storable, err := orderedMap.Get(key.AtreeValueCompare, key.AtreeValueHashInput, key.AtreeValue())
+	var keyNotFoundError *atree.KeyNotFoundError
+	if errors.As(err, &keyNotFoundError) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := slabsSize(storage, ledger, storable)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, ok := storable.(atree.StorageIDStorable); !ok {
+		size += int(storable.ByteSize())
+	}
+
+	return size, nil
+}
+
+// slabsSize returns the size of the registers of the slabs referenced by the storable and their children.
+//
+// The inline children are encoded as part of their parent, so only the slabs they reference are counted.
+func slabsSize(storage *runtime.Storage, ledger *storageLedger, storable atree.Storable) (int, error) {
+	children := storable.ChildStorables()
+	size := 0
+
+	if id, ok := storable.(atree.StorageIDStorable); ok {
+		owner := id.Address[:]
+		key := atree.SlabIndexToLedgerKey(id.Index)
+		value, err := ledger.GetValue(owner, key)
+		if err != nil {
+			return 0, err
+		}
+		size = environment.RegisterSize(flowgo.NewRegisterID(string(owner), string(key)), value)
+
+		slab, found, err := storage.Retrieve(atree.StorageID(id))
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			return 0, fmt.Errorf("slab %s not found", atree.StorageID(id))
+		}
+		children = slab.ChildStorables()
+	}
+
+	for _, child := range children {
+		childSize, err := slabsSize(storage, ledger, child)
+		if err != nil {
+			return 0, err
+		}
+		size += childSize
+	}
+
+	return size, nil
+}
@@ -0,0 +1,216 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blockchain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/gob"
+	"sort"
+	"sync"
+
+	"github.com/onflow/flow-emulator/storage"
+	"github.com/pkg/errors"
+)
+
+// newEmulatorStore creates a new empty emulator store.
+func newEmulatorStore() *emulatorStore {
+	s := &emulatorStore{
+		data: make(map[string]map[string][]storeValue),
+	}
+
+	s.DataGetter = s
+	s.DataSetter = s
+	s.KeyGenerator = &storage.DefaultKeyGenerator{}
+
+	return s
+}
+
+// emulatorStore is an in-memory emulator storage keeping all the data in a versioned key-value map.
+//
+// Opposed to the emulator memstore, the latest state of the store can be serialized into a snapshot
+// and later restored, which allows us to recreate the emulator without replaying all the blocks.
+type emulatorStore struct {
+	storage.DefaultStore
+	mu   sync.RWMutex
+	data map[string]map[string][]storeValue // store name -> key -> values sorted by version
+	size int64                              // estimated size of the data in bytes
+	// historyHeight is the lowest block height the state is available at,
+	// the earlier versions are not part of the snapshot the store was restored from.
+	historyHeight uint64
+}
+
+var _ storage.Store = &emulatorStore{}
+
+// storeValue is a value of a key at a version, height is the block height at which it was written.
+type storeValue struct {
+	Version uint64
+	Height  uint64
+	Value   []byte
+}
+
+// storeValueOverhead is the estimated size of a store value without the value bytes.
+const storeValueOverhead = 40
+
+func (v storeValue) size() int64 {
+	return storeValueOverhead + int64(len(v.Value))
+}
+
+func (s *emulatorStore) GetBytes(ctx context.Context, store string, key []byte) ([]byte, error) {
+	return s.GetBytesAtVersion(ctx, store, key, 0)
+}
+
+func (s *emulatorStore) SetBytes(ctx context.Context, store string, key []byte, value []byte) error {
+	return s.SetBytesWithVersion(ctx, store, key, value, 0)
+}
+
+func (s *emulatorStore) GetBytesAtVersion(
+	_ context.Context,
+	store string,
+	key []byte,
+	version uint64,
+) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	values := s.data[store][string(key)]
+	// find the first value with higher version, the one before is the value at provided version
+	i := sort.Search(len(values), func(i int) bool {
+		return values[i].Version > version
+	})
+	if i == 0 {
+		return nil, storage.ErrNotFound
+	}
+
+	return values[i-1].Value, nil
+}
+
+func (s *emulatorStore) SetBytesWithVersion(
+	_ context.Context,
+	store string,
+	key []byte,
+	value []byte,
+	version uint64,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.data[store]; !ok {
+		s.data[store] = make(map[string][]storeValue)
+	}
+
+	val := storeValue{
+		Version: version,
+		Height:  s.CurrentHeight,
+		Value:   append([]byte(nil), value...), // copy since the caller might reuse the slice
+	}
+
+	values := s.data[store][string(key)]
+	if len(values) == 0 {
+		s.size += int64(len(key))
+	}
+
+	i := sort.Search(len(values), func(i int) bool {
+		return values[i].Version >= version
+	})
+
+	if i < len(values) && values[i].Version == version {
+		s.size += val.size() - values[i].size()
+		values[i] = val
+	} else {
+		values = append(values, storeValue{})
+		copy(values[i+1:], values[i:])
+		values[i] = val
+		s.size += val.size()
+	}
+
+	s.data[store][string(key)] = values
+
+	return nil
+}
+
+// estimatedSize returns the estimated memory used by the store data in bytes.
+func (s *emulatorStore) estimatedSize() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.size
+}
+
+// snapshot serializes the latest version of every key in the store.
+func (s *emulatorStore) snapshot() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	latest := make(map[string]map[string][]storeValue, len(s.data))
+	for store, keys := range s.data {
+		latest[store] = make(map[string][]storeValue, len(keys))
+		for key, values := range keys {
+			latest[store][key] = values[len(values)-1:]
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+
+	err := gob.NewEncoder(writer).Encode(latest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode emulator store")
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compress emulator store")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// restoreEmulatorStore creates a new emulator store from the serialized snapshot.
+func restoreEmulatorStore(snapshot []byte) (*emulatorStore, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(snapshot))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress emulator store")
+	}
+
+	s := newEmulatorStore()
+	err = gob.NewDecoder(reader).Decode(&s.data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode emulator store")
+	}
+
+	for _, keys := range s.data {
+		for key, values := range keys {
+			s.size += int64(len(key))
+			for _, value := range values {
+				s.size += value.size()
+			}
+		}
+	}
+
+	height, err := s.LatestBlockHeight(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get restored block height")
+	}
+	s.CurrentHeight = height
+	s.historyHeight = height
+
+	return s, nil
+}
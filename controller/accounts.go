@@ -20,6 +20,7 @@ package controller
 
 import (
 	"github.com/dapperlabs/flow-playground-api/blockchain"
+	userErrors "github.com/dapperlabs/flow-playground-api/middleware/errors"
 	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/dapperlabs/flow-playground-api/storage"
 	"github.com/google/uuid"
@@ -50,26 +51,71 @@ func (a *Accounts) GetByAddress(address model.Address, projectID uuid.UUID) (*mo
 }
 
 func (a *Accounts) AllForProjectID(projectID uuid.UUID) ([]*model.Account, error) {
-	var proj model.Project
-	err := a.store.GetProject(projectID, &proj)
+	accs, err := a.blockchain.GetAllAccounts(projectID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get all project accounts")
 	}
 
-	addresses := make([]model.Address, proj.NumberOfAccounts)
-	for i := 0; i < proj.NumberOfAccounts; i++ {
-		addresses[i] = model.NewAddressFromIndex(i)
+	exported := make([]*model.Account, len(accs))
+	for i, acc := range accs {
+		exported[i] = acc.Export()
 	}
 
-	accs, err := a.blockchain.GetAccounts(projectID, addresses)
+	return exported, nil
+}
+
+func (a *Accounts) StorageDiff(
+	projectID uuid.UUID,
+	address model.Address,
+	fromHeight int,
+	toHeight int,
+) (*model.AccountStorageDiff, error) {
+	diff, err := a.blockchain.GetAccountStorageDiff(projectID, address, fromHeight, toHeight)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "failed to get account storage diff")
 	}
 
-	exported := make([]*model.Account, proj.NumberOfAccounts)
-	for i := 0; i < proj.NumberOfAccounts; i++ {
-		exported[i] = accs[i].Export()
+	return diff, nil
+}
+
+func (a *Accounts) Create(projectID uuid.UUID) (*model.Account, error) {
+	account, err := a.blockchain.CreateAccount(projectID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create account")
 	}
 
-	return exported, nil
+	return account.Export(), nil
+}
+
+// Storage returns the account storage items limited by the filter.
+func (a *Accounts) Storage(account *model.Account, filter model.StorageFilter) (*model.AccountStorage, error) {
+	err := filter.Validate()
+	if err != nil {
+		return nil, userErrors.NewUserError(err.Error())
+	}
+
+	// the simulated state is discarded, so the storage is decoded from the state captured with the account
+	if account.Simulated {
+		storage, err := model.AccountStorageFromState(account.State, account.StorageSizes, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode account storage")
+		}
+		return storage, nil
+	}
+
+	storage, err := a.blockchain.GetAccountStorage(account.ProjectID, account.Address, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account storage")
+	}
+
+	return storage, nil
+}
+
+func (a *Accounts) Fund(projectID uuid.UUID, address model.Address, amount string) (*model.Account, error) {
+	account, err := a.blockchain.FundAccount(projectID, address, amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fund account")
+	}
+
+	return account.Export(), nil
 }
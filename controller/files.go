@@ -21,6 +21,7 @@ package controller
 import (
 	"fmt"
 	"github.com/dapperlabs/flow-playground-api/blockchain"
+	userErrors "github.com/dapperlabs/flow-playground-api/middleware/errors"
 	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/dapperlabs/flow-playground-api/storage"
 	"github.com/google/uuid"
@@ -65,11 +66,24 @@ func (f *Files) CreateFile(projectID uuid.UUID, input model.NewFile, fileType mo
 }
 
 func (f *Files) UpdateFile(input model.UpdateFile) (*model.File, error) {
+	// the code which can't be formatted is saved as it is, so the edit is not lost
+	var formatError *string
+	if input.Format != nil && *input.Format && input.Script != nil {
+		script, err := blockchain.FormatCode(*input.Script)
+		if err != nil {
+			message := err.Error()
+			formatError = &message
+		} else {
+			input.Script = &script
+		}
+	}
+
 	var file model.File
 	err := f.store.UpdateFile(input, &file)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update cadence file")
 	}
+	file.FormatError = formatError
 
 	err = f.fileChanged(input.ProjectID)
 	if err != nil {
@@ -105,6 +119,12 @@ func (f *Files) CreateScriptExecution(input model.NewScriptExecution) (*model.Sc
 		return nil, errors.New("cannot execute empty script")
 	}
 
+	arguments, err := blockchain.ResolveNamedArguments(input.Script, input.Arguments, input.NamedArguments)
+	if err != nil {
+		return nil, err
+	}
+	input.Arguments = arguments
+
 	execution, err := f.blockchain.ExecuteScript(input)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to execute script")
@@ -123,6 +143,12 @@ func (f *Files) CreateTransactionExecution(input model.NewTransactionExecution)
 		return nil, errors.New("cannot execute empty transaction script")
 	}
 
+	arguments, err := blockchain.ResolveNamedArguments(input.Script, input.Arguments, input.NamedArguments)
+	if err != nil {
+		return nil, err
+	}
+	input.Arguments = arguments
+
 	exe, err := f.blockchain.ExecuteTransaction(input)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to execute transaction")
@@ -136,12 +162,39 @@ func (f *Files) CreateTransactionExecution(input model.NewTransactionExecution)
 	return exe, nil
 }
 
+func (f *Files) SimulateTransaction(input model.NewTransactionExecution) (*model.TransactionSimulation, error) {
+	if len(input.Script) == 0 {
+		return nil, errors.New("cannot simulate empty transaction script")
+	}
+
+	arguments, err := blockchain.ResolveNamedArguments(input.Script, input.Arguments, input.NamedArguments)
+	if err != nil {
+		return nil, err
+	}
+	input.Arguments = arguments
+
+	simulation, err := f.blockchain.SimulateTransaction(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to simulate transaction")
+	}
+
+	return simulation, nil
+}
+
 func (f *Files) DeployContract(input model.NewContractDeployment) (*model.ContractDeployment, error) {
 	if len(input.Script) == 0 {
 		return nil, errors.New("cannot deploy empty contract")
 	}
 
-	deploy, err := f.blockchain.DeployContract(input.ProjectID, input.Address, input.Script, input.Arguments)
+	arguments, err := blockchain.ResolveNamedArguments(input.Script, input.Arguments, input.NamedArguments)
+	if err != nil {
+		return nil, err
+	}
+	input.Arguments = arguments
+
+	update := input.Update != nil && *input.Update
+
+	deploy, err := f.blockchain.DeployContract(input.ProjectID, input.Address, input.Script, input.Arguments, update)
 	if err != nil {
 		fmt.Println("Failed to deploy contract")
 		return nil, errors.Wrap(err, "failed to deploy contract")
@@ -155,6 +208,38 @@ func (f *Files) DeployContract(input model.NewContractDeployment) (*model.Contra
 	return deploy, nil
 }
 
+func (f *Files) RemoveContract(projectID uuid.UUID, address model.Address, name string) (*model.Account, error) {
+	account, err := f.blockchain.RemoveContract(projectID, address, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to remove contract")
+	}
+
+	err = f.fileChanged(projectID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update project from removed contract")
+	}
+
+	return account.Export(), nil
+}
+
+func (f *Files) CreateExecutionBatch(input model.NewExecutionBatch) ([]*model.ExecutionBatchResult, error) {
+	if len(input.Steps) == 0 {
+		return nil, errors.New("cannot execute empty batch")
+	}
+
+	results, err := f.blockchain.ExecuteBatch(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute batch")
+	}
+
+	err = f.fileChanged(input.ProjectID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update project from execution batch")
+	}
+
+	return results, nil
+}
+
 func (f *Files) GetFilesForProject(projID uuid.UUID, fileType model.FileType) ([]*model.File, error) {
 	var files []*model.File
 
@@ -179,3 +264,38 @@ func (f *Files) GetFile(id uuid.UUID, projID uuid.UUID) (*model.File, error) {
 func (f *Files) GetFlowJson(projID uuid.UUID) (string, error) {
 	return f.blockchain.GetFlowJson(projID)
 }
+
+// CheckCode type-checks the code against the project contracts without changing the project state.
+func (f *Files) CheckCode(projID uuid.UUID, script string, kind model.CodeKind) ([]*model.ProgramError, error) {
+	programErrors, err := f.blockchain.CheckCode(projID, script, kind)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check code")
+	}
+
+	result := make([]*model.ProgramError, len(programErrors))
+	for i := range programErrors {
+		result[i] = &programErrors[i]
+	}
+
+	return result, nil
+}
+
+// ProgramSignature returns the parameters, the number of signers and the return type of the code.
+func (f *Files) ProgramSignature(script string) (*model.ProgramSignature, error) {
+	signature, err := blockchain.ParseProgramSignature(script)
+	if err != nil {
+		return nil, userErrors.NewUserError(err.Error())
+	}
+
+	return signature, nil
+}
+
+// FormatCode returns the canonically formatted code.
+func (f *Files) FormatCode(script string) (string, error) {
+	formatted, err := blockchain.FormatCode(script)
+	if err != nil {
+		return "", userErrors.NewUserError(err.Error())
+	}
+
+	return formatted, nil
+}
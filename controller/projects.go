@@ -61,6 +61,17 @@ func (p *Projects) Create(user *model.User, input model.NewProject) (*model.Proj
 			config.Playground().MaxProjectsLimit))
 	}
 
+	if input.NumberOfAccounts > config.Playground().MaxAccountsLimit {
+		return nil, userErrors.NewUserError(fmt.Sprintf("maximum number of %d accounts exceeded",
+			config.Playground().MaxAccountsLimit))
+	}
+
+	if input.EmulatorConfig != nil {
+		if err := input.EmulatorConfig.Validate(); err != nil {
+			return nil, userErrors.NewUserError(err.Error())
+		}
+	}
+
 	proj := &model.Project{
 		ID:               uuid.New(),
 		Secret:           uuid.New(),
@@ -72,6 +83,7 @@ func (p *Projects) Create(user *model.User, input model.NewProject) (*model.Proj
 		Readme:           input.Readme,
 		Persist:          false,
 		NumberOfAccounts: input.NumberOfAccounts,
+		EmulatorConfig:   model.DefaultEmulatorConfig().Apply(input.EmulatorConfig),
 		AccessedAt:       time.Now(),
 		Version:          p.version,
 		UserID:           user.ID,
@@ -117,6 +129,11 @@ func (p *Projects) Create(user *model.User, input model.NewProject) (*model.Proj
 		return nil, errors.Wrap(err, "failed to create project")
 	}
 
+	err = p.blockchain.InitAccounts(proj.ID, proj.NumberOfAccounts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create project accounts")
+	}
+
 	return proj, nil
 }
 
@@ -168,7 +185,7 @@ func (p *Projects) GetProjectListForUser(userID uuid.UUID) (*model.ProjectList,
 
 func (p *Projects) Update(input model.UpdateProject) (*model.Project, error) {
 	var proj model.Project
-	err := p.store.UpdateProject(input, &proj)
+	err := p.blockchain.UpdateProject(input, &proj)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update project")
 	}
@@ -188,3 +205,53 @@ func (p *Projects) UpdateVersion(id uuid.UUID, version *semver.Version) error {
 func (p *Projects) Reset(projID uuid.UUID) error {
 	return p.blockchain.Reset(projID)
 }
+
+func (p *Projects) Rollback(projID uuid.UUID, blockHeight int) error {
+	return p.blockchain.Rollback(projID, blockHeight)
+}
+
+func (p *Projects) GetEvents(projID uuid.UUID, filter model.EventFilter) (*model.EventList, error) {
+	err := filter.Validate()
+	if err != nil {
+		return nil, userErrors.NewUserError(err.Error())
+	}
+
+	var events []*model.ProjectEvent
+	var total int64
+	err = p.store.GetEventsForProject(projID, filter, &events, &total)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get events")
+	}
+
+	return &model.EventList{
+		Events:     events,
+		TotalCount: int(total),
+	}, nil
+}
+
+func (p *Projects) CommitBlocks(projID uuid.UUID, count int) (*model.Block, error) {
+	block, err := p.blockchain.CommitBlocks(projID, count)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to commit blocks")
+	}
+
+	return block, nil
+}
+
+func (p *Projects) SetBlockTimestamp(projID uuid.UUID, timestamp time.Time) (*model.Block, error) {
+	block, err := p.blockchain.SetBlockTimestamp(projID, timestamp)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set block timestamp")
+	}
+
+	return block, nil
+}
+
+func (p *Projects) AdvanceBlockTimestamp(projID uuid.UUID, duration time.Duration) (*model.Block, error) {
+	block, err := p.blockchain.AdvanceBlockTimestamp(projID, duration)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to advance block timestamp")
+	}
+
+	return block, nil
+}
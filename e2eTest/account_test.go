@@ -128,3 +128,270 @@ func TestAccountStorage(t *testing.T) {
 	assert.Contains(t, accResp.Account.State, `publicTest`)
 	assert.Contains(t, accResp.Account.State, `privateTest`)
 }
+
+func TestAccountStructuredStorage(t *testing.T) {
+	c := newClient()
+
+	project := createProject(t, c)
+	account := project.Accounts[0]
+
+	var resp CreateTransactionExecutionResponse
+	err := c.Post(
+		MutationCreateTransactionExecution,
+		&resp,
+		client.Var("projectId", project.ID),
+		client.Var("script", `
+		transaction {
+			prepare(signer: AuthAccount) {
+				signer.save("storage value", to: /storage/storageTest)
+				signer.save([1, 2, 3], to: /storage/numbers)
+				signer.link<&String>(/public/publicTest, target: /storage/storageTest)
+			}
+		}`),
+		client.Var("signers", []string{account.Address}),
+		client.AddCookie(c.SessionCookie()),
+	)
+	require.NoError(t, err)
+	require.Empty(t, resp.CreateTransactionExecution.Errors)
+
+	getStorage := func(t *testing.T, options ...client.Option) GetAccountStorageResponse {
+		var storageResp GetAccountStorageResponse
+		err := c.Post(
+			QueryGetAccountStorage,
+			&storageResp,
+			append([]client.Option{
+				client.Var("projectId", project.ID),
+				client.Var("address", account.Address),
+			}, options...)...,
+		)
+		require.NoError(t, err)
+		return storageResp
+	}
+
+	all := getStorage(t).Account.Storage
+	require.Len(t, all.Items, all.TotalCount)
+
+	t.Run("decoded items", func(t *testing.T) {
+		items := make(map[string]int)
+		for i, item := range all.Items {
+			items[item.Path] = i
+		}
+
+		link := all.Items[items["/public/publicTest"]]
+		assert.Equal(t, "PUBLIC", link.Domain)
+		require.NotNil(t, link.Target)
+		assert.Equal(t, "/storage/storageTest", *link.Target)
+
+		numbers := all.Items[items["/storage/numbers"]]
+		assert.Equal(t, "/storage/numbers", numbers.Path)
+		assert.Equal(t, "STORAGE", numbers.Domain)
+		assert.Nil(t, numbers.Target)
+		assert.Greater(t, numbers.Size, 0)
+		require.NotNil(t, numbers.Value)
+		assert.Equal(t, "[Int]", numbers.Value.Type)
+		require.Len(t, numbers.Value.Fields, 3)
+		assert.Equal(t, "0", numbers.Value.Fields[0].Name)
+		assert.Equal(t, "1", *numbers.Value.Fields[0].Value.Value)
+
+		value := all.Items[items["/storage/storageTest"]]
+		assert.Equal(t, "/storage/storageTest", value.Path)
+		require.NotNil(t, value.Value)
+		assert.Equal(t, "String", value.Value.Type)
+		assert.Equal(t, `"storage value"`, *value.Value.Value)
+	})
+
+	t.Run("paginated with depth limit", func(t *testing.T) {
+		storage := getStorage(
+			t,
+			client.Var("offset", 1),
+			client.Var("limit", 1),
+			client.Var("depth", 0),
+		).Account.Storage
+
+		assert.Equal(t, all.TotalCount, storage.TotalCount)
+		require.Len(t, storage.Items, 1)
+		assert.Equal(t, all.Items[1].Path, storage.Items[0].Path)
+		assert.Empty(t, storage.Items[0].Value.Fields)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		var storageResp GetAccountStorageResponse
+		err := c.Post(
+			QueryGetAccountStorage,
+			&storageResp,
+			client.Var("projectId", project.ID),
+			client.Var("address", account.Address),
+			client.Var("limit", 0),
+		)
+		assert.Error(t, err)
+	})
+}
+
+func TestAccountStorageDiff(t *testing.T) {
+	c := newClient()
+
+	project := createProject(t, c)
+	account := project.Accounts[0]
+
+	var resp CreateTransactionExecutionResponse
+	err := c.Post(
+		MutationCreateTransactionExecution,
+		&resp,
+		client.Var("projectId", project.ID),
+		client.Var("script", `
+		transaction {
+			prepare(signer: AuthAccount) {
+				signer.save(42, to: /storage/answer)
+			}
+		}`),
+		client.Var("signers", []string{account.Address}),
+		client.AddCookie(c.SessionCookie()),
+	)
+	require.NoError(t, err)
+	require.Empty(t, resp.CreateTransactionExecution.Errors)
+
+	var diffResp GetAccountStorageDiffResponse
+	err = c.Post(
+		QueryGetAccountStorageDiff,
+		&diffResp,
+		client.Var("projectId", project.ID),
+		client.Var("address", account.Address),
+		client.Var("fromHeight", InitBlockHeight),
+		client.Var("toHeight", InitBlockHeight+1),
+	)
+	require.NoError(t, err)
+
+	diff := diffResp.AccountStorageDiff
+	assert.Equal(t, account.Address, diff.Address)
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, "/storage/answer", diff.Changes[0].Path)
+	assert.Equal(t, "ADDED", diff.Changes[0].Kind)
+	assert.Nil(t, diff.Changes[0].Before)
+	require.NotNil(t, diff.Changes[0].After)
+	assert.Equal(t, "42", *diff.Changes[0].After.Value.Value)
+}
+
+func TestCreateAccount(t *testing.T) {
+
+	t.Run("Create account", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var resp CreateAccountResponse
+		err := c.Post(
+			MutationCreateAccount,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "000000000000000a", resp.CreateAccount.Address)
+
+		var projResp GetProjectAccountsResponse
+		err = c.Post(
+			QueryGetProjectAccounts,
+			&projResp,
+			client.Var("projectId", project.ID),
+		)
+		require.NoError(t, err)
+		require.Len(t, projResp.Project.Accounts, initAccounts+1)
+		assert.Equal(t, "000000000000000a", projResp.Project.Accounts[initAccounts].Address)
+	})
+
+	t.Run("Create account without permission", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var resp CreateAccountResponse
+		err := c.Post(
+			MutationCreateAccount,
+			&resp,
+			client.Var("projectId", project.ID),
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("Create project with more accounts", func(t *testing.T) {
+		c := newClient()
+
+		var resp CreateProjectResponse
+		err := c.Post(
+			MutationCreateProject,
+			&resp,
+			client.Var("title", "foo"),
+			client.Var("seed", 42),
+			client.Var("description", "desc"),
+			client.Var("readme", "rtfm"),
+			client.Var("numberOfAccounts", initAccounts+2),
+			client.Var("transactionTemplates", []string{}),
+			client.Var("scriptTemplates", []string{}),
+			client.Var("contractTemplates", []string{}),
+		)
+		require.NoError(t, err)
+		require.Len(t, resp.CreateProject.Accounts, initAccounts+2)
+
+		projectID := resp.CreateProject.ID
+
+		var resetResp ResetProjectResponse
+		err = c.Post(
+			MutationResetProjectState,
+			&resetResp,
+			client.Var("projectId", projectID),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		// accounts are recreated after the project reset
+		var projResp GetProjectAccountsResponse
+		err = c.Post(
+			QueryGetProjectAccounts,
+			&projResp,
+			client.Var("projectId", projectID),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, initAccounts+2, projResp.Project.NumberOfAccounts)
+		assert.Len(t, projResp.Project.Accounts, initAccounts+2)
+	})
+}
+
+func TestFundAccount(t *testing.T) {
+
+	t.Run("Fund account", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+		account := project.Accounts[0]
+
+		var resp FundAccountResponse
+		err := c.Post(
+			MutationFundAccount,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("address", account.Address),
+			client.Var("amount", "100.0"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, account.Address, resp.FundAccount.Address)
+		assert.Equal(t, "100.00000000", resp.FundAccount.Balance)
+		assert.NotEmpty(t, resp.FundAccount.AvailableBalance)
+	})
+
+	t.Run("Fund account without permission", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var resp FundAccountResponse
+		err := c.Post(
+			MutationFundAccount,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("address", project.Accounts[0].Address),
+			client.Var("amount", "100.0"),
+		)
+		assert.Error(t, err)
+	})
+}
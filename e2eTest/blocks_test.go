@@ -0,0 +1,141 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2eTest
+
+import (
+	"github.com/dapperlabs/flow-playground-api/e2eTest/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBlockControl(t *testing.T) {
+	const timestampScript = `pub fun main(): UFix64 { return getCurrentBlock().timestamp }`
+
+	t.Run("Commit empty blocks", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var resp CommitBlocksResponse
+		err := c.Post(
+			MutationCommitBlocks,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("count", 3),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, InitBlockHeight+3, resp.CommitBlocks.Height)
+		assert.Len(t, resp.CommitBlocks.ID, 64)
+
+		var txResp CreateTransactionExecutionResponse
+		err = c.Post(
+			MutationCreateTransactionExecution,
+			&txResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", "transaction {}"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		err = c.Post(
+			MutationCommitBlocks,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("count", 1),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, InitBlockHeight+5, resp.CommitBlocks.Height)
+	})
+
+	t.Run("Commit blocks without permission", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var resp CommitBlocksResponse
+		err := c.Post(
+			MutationCommitBlocks,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("count", 1),
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("Set and advance block timestamp", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var setResp SetBlockTimestampResponse
+		err := c.Post(
+			MutationSetBlockTimestamp,
+			&setResp,
+			client.Var("projectId", project.ID),
+			client.Var("timestamp", "2030-01-01T00:00:00Z"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "2030-01-01T00:00:00Z", setResp.SetBlockTimestamp.Timestamp)
+		assert.Equal(t, InitBlockHeight+1, setResp.SetBlockTimestamp.Height)
+
+		var advanceResp AdvanceBlockTimestampResponse
+		err = c.Post(
+			MutationAdvanceBlockTimestamp,
+			&advanceResp,
+			client.Var("projectId", project.ID),
+			client.Var("seconds", 3600),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "2030-01-01T01:00:00Z", advanceResp.AdvanceBlockTimestamp.Timestamp)
+
+		var scriptResp CreateScriptExecutionResponse
+		err = c.Post(
+			MutationCreateScriptExecution,
+			&scriptResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", timestampScript),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "1893459600.00000000", scriptResp.CreateScriptExecution.Value)
+	})
+
+	t.Run("Advance block timestamp by invalid seconds", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		for _, seconds := range []int{0, -1, math.MaxInt64 / int(time.Second)} {
+			var resp AdvanceBlockTimestampResponse
+			err := c.Post(
+				MutationAdvanceBlockTimestamp,
+				&resp,
+				client.Var("projectId", project.ID),
+				client.Var("seconds", seconds),
+				client.AddCookie(c.SessionCookie()),
+			)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "seconds must be between 1 and")
+		}
+	})
+}
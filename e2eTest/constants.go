@@ -107,6 +107,189 @@ mutation($projectId: UUID!) {
 }
 `
 
+const MutationRollbackProject = `
+mutation($projectId: UUID!, $blockHeight: Int!) {
+  rollbackProject(projectId: $projectId, blockHeight: $blockHeight) {
+    id
+    transactionExecutions {
+      id
+    }
+    contractDeployments {
+      id
+      blockHeight
+    }
+  }
+}
+`
+
+type RollbackProjectResponse struct {
+	RollbackProject struct {
+		ID                    string
+		TransactionExecutions []struct {
+			ID string
+		}
+		ContractDeployments []struct {
+			ID          string
+			BlockHeight int
+		}
+	}
+}
+
+const QueryGetProjectHistory = `
+query($projectId: UUID!) {
+  project(id: $projectId) {
+    id
+    transactionExecutions {
+      id
+    }
+    contractDeployments {
+      id
+      blockHeight
+    }
+  }
+}
+`
+
+type GetProjectHistoryResponse struct {
+	Project struct {
+		ID                    string
+		TransactionExecutions []struct {
+			ID string
+		}
+		ContractDeployments []struct {
+			ID          string
+			BlockHeight int
+		}
+	}
+}
+
+type Block struct {
+	ID        string
+	Height    int
+	Timestamp string
+}
+
+const MutationCommitBlocks = `
+mutation($projectId: UUID!, $count: Int!) {
+  commitBlocks(projectId: $projectId, count: $count) {
+    id
+    height
+    timestamp
+  }
+}
+`
+
+type CommitBlocksResponse struct {
+	CommitBlocks Block
+}
+
+const MutationSetBlockTimestamp = `
+mutation($projectId: UUID!, $timestamp: Time!) {
+  setBlockTimestamp(projectId: $projectId, timestamp: $timestamp) {
+    id
+    height
+    timestamp
+  }
+}
+`
+
+type SetBlockTimestampResponse struct {
+	SetBlockTimestamp Block
+}
+
+const MutationAdvanceBlockTimestamp = `
+mutation($projectId: UUID!, $seconds: Int!) {
+  advanceBlockTimestamp(projectId: $projectId, seconds: $seconds) {
+    id
+    height
+    timestamp
+  }
+}
+`
+
+type AdvanceBlockTimestampResponse struct {
+	AdvanceBlockTimestamp Block
+}
+
+const QueryGetEvents = `
+query($projectId: UUID!, $type: String, $address: Address, $fromHeight: Int, $toHeight: Int, $offset: Int, $limit: Int) {
+  events(projectId: $projectId, type: $type, address: $address, fromHeight: $fromHeight, toHeight: $toHeight, offset: $offset, limit: $limit) {
+    events {
+      type
+      values
+      address
+      blockHeight
+      transactionId
+      eventIndex
+    }
+    totalCount
+  }
+}
+`
+
+type GetEventsResponse struct {
+	Events struct {
+		Events []struct {
+			Type          string
+			Values        []string
+			Address       *string
+			BlockHeight   int
+			TransactionID string
+			EventIndex    int
+		}
+		TotalCount int
+	}
+}
+
+const MutationCreateProjectWithEmulatorConfig = `
+mutation($title: String!, $description: String!, $readme: String!, $seed: Int!, $numberOfAccounts: Int!, $emulatorConfig: EmulatorConfigInput) {
+  createProject(input: { title: $title, description: $description, readme: $readme, seed: $seed, numberOfAccounts: $numberOfAccounts, emulatorConfig: $emulatorConfig }) {
+    id
+    emulatorConfig {
+      transactionFeesEnabled
+      storageLimitEnabled
+      transactionValidationEnabled
+      computationLimit
+    }
+  }
+}
+`
+
+type CreateProjectWithEmulatorConfigResponse struct {
+	CreateProject struct {
+		ID             string
+		EmulatorConfig EmulatorConfig
+	}
+}
+
+const MutationUpdateProjectEmulatorConfig = `
+mutation($projectId: UUID!, $emulatorConfig: EmulatorConfigInput!) {
+  updateProject(input: { id: $projectId, emulatorConfig: $emulatorConfig }) {
+    id
+    emulatorConfig {
+      transactionFeesEnabled
+      storageLimitEnabled
+      transactionValidationEnabled
+      computationLimit
+    }
+  }
+}
+`
+
+type UpdateProjectEmulatorConfigResponse struct {
+	UpdateProject struct {
+		ID             string
+		EmulatorConfig EmulatorConfig
+	}
+}
+
+type EmulatorConfig struct {
+	TransactionFeesEnabled       bool
+	StorageLimitEnabled          bool
+	TransactionValidationEnabled bool
+	ComputationLimit             int
+}
+
 const MutationDeleteProject = `
 mutation($projectId: UUID!) {
   deleteProject(projectId: $projectId)
@@ -127,6 +310,106 @@ type GetAccountResponse struct {
 	Account Account
 }
 
+const QueryGetAccountStorage = `
+query($address: Address!, $projectId: UUID!, $offset: Int, $limit: Int, $depth: Int) {
+  account(address: $address, projectId: $projectId) {
+    storage(offset: $offset, limit: $limit, depth: $depth) {
+      items {
+        path
+        domain
+        type
+        value {
+          type
+          value
+          truncated
+          fields {
+            name
+            value {
+              type
+              value
+              truncated
+            }
+          }
+        }
+        target
+        size
+      }
+      totalCount
+    }
+  }
+}
+`
+
+type StorageValue struct {
+	Type      string
+	Value     *string
+	Truncated bool
+	Fields    []struct {
+		Name  string
+		Value StorageValue
+	}
+}
+
+type GetAccountStorageResponse struct {
+	Account struct {
+		Storage struct {
+			Items []struct {
+				Path   string
+				Domain string
+				Type   string
+				Value  *StorageValue
+				Target *string
+				Size   int
+			}
+			TotalCount int
+		}
+	}
+}
+
+const QueryGetAccountStorageDiff = `
+query($projectId: UUID!, $address: Address!, $fromHeight: Int!, $toHeight: Int!) {
+  accountStorageDiff(projectId: $projectId, address: $address, fromHeight: $fromHeight, toHeight: $toHeight) {
+    address
+    fromHeight
+    toHeight
+    changes {
+      path
+      domain
+      kind
+      before {
+        value {
+          value
+        }
+      }
+      after {
+        value {
+          value
+        }
+      }
+    }
+  }
+}
+`
+
+type GetAccountStorageDiffResponse struct {
+	AccountStorageDiff struct {
+		Address    string
+		FromHeight int
+		ToHeight   int
+		Changes    []struct {
+			Path   string
+			Domain string
+			Kind   string
+			Before *struct {
+				Value StorageValue
+			}
+			After *struct {
+				Value StorageValue
+			}
+		}
+	}
+}
+
 const QueryGetProjectStorage = `
 query($projectId: UUID!) {
   project(id: $projectId) {
@@ -154,6 +437,60 @@ query($projectId: UUID!) {
 }
 `
 
+const MutationCreateAccount = `
+mutation($projectId: UUID!) {
+  createAccount(projectId: $projectId) {
+    address
+    deployedContracts
+    state
+  }
+}
+`
+
+type CreateAccountResponse struct {
+	CreateAccount Account
+}
+
+const MutationFundAccount = `
+mutation($projectId: UUID!, $address: Address!, $amount: String!) {
+  fundAccount(projectId: $projectId, address: $address, amount: $amount) {
+    address
+    balance
+    availableBalance
+  }
+}
+`
+
+type FundAccountResponse struct {
+	FundAccount struct {
+		Address          string
+		Balance          string
+		AvailableBalance string
+	}
+}
+
+const QueryGetProjectAccounts = `
+query($projectId: UUID!) {
+  project(id: $projectId) {
+    id
+    numberOfAccounts
+    accounts {
+      address
+    }
+  }
+}
+`
+
+type GetProjectAccountsResponse struct {
+	Project struct {
+		ID               string
+		NumberOfAccounts int
+		Accounts         []struct {
+			Address string
+		}
+	}
+}
+
 const QueryGetProject = `
 query($projectId: UUID!) {
   project(id: $projectId) {
@@ -385,6 +722,17 @@ mutation($templateId: UUID!, $projectId: UUID!, $script: String!) {
 }
 `
 
+const MutationUpdateContractTemplateFormattedScript = `
+mutation($templateId: UUID!, $projectId: UUID!, $script: String!) {
+  updateContractTemplate(input: { id: $templateId, projectId: $projectId, script: $script, format: true }) {
+    id
+    script
+    index
+    formatError
+  }
+}
+`
+
 const MutationUpdateContractTemplateTitle = `
 mutation($templateId: UUID!, $projectId: UUID!, $title: String) {
   updateContractTemplate(input: { id: $templateId, projectId: $projectId, title: $title }) {
@@ -408,10 +756,11 @@ mutation($templateId: UUID!, $projectId: UUID!, $index: Int!) {
 
 type UpdateContractTemplateResponse struct {
 	UpdateContractTemplate struct {
-		ID     string
-		Index  int
-		Title  string
-		Script string
+		ID          string
+		Index       int
+		Title       string
+		Script      string
+		FormatError *string
 	}
 }
 
@@ -436,32 +785,70 @@ type DeleteContractTemplateResponse struct {
 }
 
 const MutationCreateTransactionExecution = `
-mutation($projectId: UUID!, $script: String!, $signers: [Address!], $arguments: [String!]) {
+mutation($projectId: UUID!, $script: String!, $signers: [Address!], $payer: Address, $proposer: Address, $arguments: [String!]) {
   createTransactionExecution(input: {
     projectId: $projectId,
     script: $script,
     arguments: $arguments,
-    signers: $signers
+    signers: $signers,
+    payer: $payer,
+    proposer: $proposer
   }) {
     id
     script
+    signers
+    payer
+    proposer
     errors {
       message
       startPosition { offset line column }
       endPosition { offset line column }
     }
     logs
+    logEntries { level message line transactionId }
     events {
       type
       values
     }
+    transactionId
+    status
+    computationUsed
+    memoryEstimate
+    fee
   }
 }
 `
 
-type CreateTransactionExecutionResponse struct {
-	CreateTransactionExecution struct {
-		ID     string
+const MutationSimulateTransaction = `
+mutation($projectId: UUID!, $script: String!, $signers: [Address!], $arguments: [String!]) {
+  simulateTransaction(input: {
+    projectId: $projectId,
+    script: $script,
+    arguments: $arguments,
+    signers: $signers
+  }) {
+    script
+    errors {
+      message
+      startPosition { offset line column }
+      endPosition { offset line column }
+    }
+    logs
+    events {
+      type
+      values
+    }
+    accounts {
+      address
+      deployedContracts
+      state
+    }
+  }
+}
+`
+
+type SimulateTransactionResponse struct {
+	SimulateTransaction struct {
 		Script string
 		Errors []model.ProgramError
 		Logs   []string
@@ -469,15 +856,46 @@ type CreateTransactionExecutionResponse struct {
 			Type   string
 			Values []string
 		}
+		Accounts []Account
+	}
+}
+
+type CreateTransactionExecutionResponse struct {
+	CreateTransactionExecution struct {
+		ID         string
+		Script     string
+		Signers    []string
+		Payer      string
+		Proposer   string
+		Errors     []model.ProgramError
+		Logs       []string
+		LogEntries []model.LogEntry
+		Events     []struct {
+			Type   string
+			Values []string
+		}
+		TransactionID   string
+		Status          string
+		ComputationUsed int
+		MemoryEstimate  int
+		Fee             string
 	}
 }
 
 const MutationCreateScriptExecution = `
-mutation CreateScriptExecution($projectId: UUID!, $script: String!, $arguments: [String!]) {
+mutation CreateScriptExecution(
+  $projectId: UUID!,
+  $script: String!,
+  $arguments: [String!],
+  $namedArguments: [NamedArgument!],
+  $blockHeight: Int
+) {
   createScriptExecution(input: {
     projectId: $projectId,
     script: $script,
-    arguments: $arguments
+    arguments: $arguments,
+    namedArguments: $namedArguments,
+    blockHeight: $blockHeight
   }) {
     id
     script
@@ -487,18 +905,21 @@ mutation CreateScriptExecution($projectId: UUID!, $script: String!, $arguments:
       endPosition { offset line column }
     }
     logs
+    logEntries { level message line transactionId }
     value
+    blockHeight
   }
 }
 `
 
 const MutationCreateContractDeployment = `
-mutation($projectId: UUID!, $script: String!, $address: Address!, $arguments: [String!]) {
+mutation($projectId: UUID!, $script: String!, $address: Address!, $arguments: [String!], $update: Boolean) {
   createContractDeployment(input: {
 	projectId: $projectId,
 	script: $script,
 	address: $address
 	arguments: $arguments
+	update: $update
   }) {
     id
 	title
@@ -516,17 +937,24 @@ mutation($projectId: UUID!, $script: String!, $address: Address!, $arguments: [S
       values
     }
     logs
+    transactionId
+    status
+    computationUsed
+    memoryEstimate
+    fee
   }
 }
 `
 
 type CreateScriptExecutionResponse struct {
 	CreateScriptExecution struct {
-		ID     string
-		Script string
-		Errors []model.ProgramError
-		Logs   []string
-		Value  string
+		ID          string
+		Script      string
+		Errors      []model.ProgramError
+		Logs        []string
+		LogEntries  []model.LogEntry
+		Value       string
+		BlockHeight *int
 	}
 }
 
@@ -565,10 +993,28 @@ type CreateContractDeploymentResponse struct {
 			Type   string
 			Values []string
 		}
-		Logs []string
+		Logs            []string
+		TransactionID   string
+		Status          string
+		ComputationUsed int
+		MemoryEstimate  int
+		Fee             string
 	}
 }
 
+const MutationRemoveContract = `
+mutation($projectId: UUID!, $address: Address!, $name: String!) {
+  removeContract(projectId: $projectId, address: $address, name: $name) {
+    address
+    deployedContracts
+  }
+}
+`
+
+type RemoveContractResponse struct {
+	RemoveContract Account
+}
+
 type ScriptTemplate struct {
 	ID     string
 	Title  string
@@ -654,6 +1100,98 @@ type GetFlowJsonResponse struct {
 	FlowJson string
 }
 
+const QueryCheckCode = `
+query($projectId: UUID!, $script: String!, $kind: CodeKind!) {
+  checkCode(projectId: $projectId, script: $script, kind: $kind) {
+    message
+    startPosition { offset line column }
+    endPosition { offset line column }
+  }
+}
+`
+
+type CheckCodeResponse struct {
+	CheckCode []model.ProgramError
+}
+
+const QueryProgramSignature = `
+query($script: String!) {
+  programSignature(script: $script) {
+    kind
+    parameters {
+      name
+      type
+    }
+    signers
+    returnType
+  }
+}
+`
+
+const QueryFormatCode = `
+query($script: String!) {
+  formatCode(script: $script)
+}
+`
+
+type FormatCodeResponse struct {
+	FormatCode string
+}
+
+type ProgramSignatureResponse struct {
+	ProgramSignature struct {
+		Kind       string
+		Parameters []struct {
+			Name string
+			Type string
+		}
+		Signers    int
+		ReturnType *string
+	}
+}
+
 // todo add tests for:
 // - failed transactions with successful transactions work (bootstrap works)??
 // - assert we don't leak any internal model data to API
+
+const MutationCreateExecutionBatch = `
+mutation($projectId: UUID!, $steps: [ExecutionBatchStep!]!) {
+  createExecutionBatch(input: { projectId: $projectId, steps: $steps }) {
+    contractDeployment {
+      id
+      title
+      blockHeight
+    }
+    transactionExecution {
+      id
+      errors {
+        message
+      }
+      logs
+    }
+    scriptExecution {
+      id
+      value
+    }
+  }
+}
+`
+
+type CreateExecutionBatchResponse struct {
+	CreateExecutionBatch []struct {
+		ContractDeployment *struct {
+			ID          string
+			Title       string
+			BlockHeight int
+		}
+		TransactionExecution *struct {
+			ID     string
+			Errors []model.ProgramError
+			Logs   []string
+		}
+		ScriptExecution *struct {
+			ID    string
+			Value string
+		}
+	}
+}
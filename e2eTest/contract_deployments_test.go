@@ -90,6 +90,9 @@ func TestContractDeployments(t *testing.T) {
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, args, resp.CreateContractDeployment.Arguments)
+		assert.Len(t, resp.CreateContractDeployment.TransactionID, 64)
+		assert.Equal(t, "SEALED", resp.CreateContractDeployment.Status)
+		assert.Greater(t, resp.CreateContractDeployment.ComputationUsed, 0)
 	})
 
 }
@@ -223,6 +226,92 @@ func TestImportSyntax(t *testing.T) {
 	})
 }
 
+func TestContractRemoval(t *testing.T) {
+	const contract = `pub contract HelloWorld {}`
+
+	t.Run("remove deployed contract", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var deployResp CreateContractDeploymentResponse
+		err := c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", contract),
+			client.Var("address", addr1),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		var resp RemoveContractResponse
+		err = c.Post(
+			MutationRemoveContract,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("address", addr1),
+			client.Var("name", "HelloWorld"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, addr1, resp.RemoveContract.Address)
+		assert.Empty(t, resp.RemoveContract.DeployedContracts)
+
+		var accResp GetAccountResponse
+		err = c.Post(
+			QueryGetAccount,
+			&accResp,
+			client.Var("projectId", project.ID),
+			client.Var("address", addr1),
+		)
+		require.NoError(t, err)
+		assert.Empty(t, accResp.Account.DeployedContracts)
+
+		// removed contract can be deployed again
+		err = c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", contract),
+			client.Var("address", addr1),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("remove non-existent contract", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var resp RemoveContractResponse
+		err := c.Post(
+			MutationRemoveContract,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("address", addr1),
+			client.Var("name", "HelloWorld"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "contract HelloWorld is not deployed")
+	})
+
+	t.Run("remove contract without permission", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var resp RemoveContractResponse
+		err := c.Post(
+			MutationRemoveContract,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("address", addr1),
+			client.Var("name", "HelloWorld"),
+		)
+		assert.Error(t, err)
+	})
+}
+
 func TestContractRedeployment(t *testing.T) {
 	t.Run("same contract name with different arguments", func(t *testing.T) {
 		c := newClient()
@@ -499,6 +588,112 @@ func TestContractRedeployment(t *testing.T) {
 
 }
 
+func TestContractUpdate(t *testing.T) {
+	const contract = `
+	pub contract HelloWorld {
+		pub var A: String
+		pub init() { self.A = "Hello" }
+	}`
+
+	t.Run("update preserves later history", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var deployResp CreateContractDeploymentResponse
+		err := c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", contract),
+			client.Var("address", addr1),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		var txResp CreateTransactionExecutionResponse
+		err = c.Post(
+			MutationCreateTransactionExecution,
+			&txResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", "transaction { execute { log(1) } }"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		err = c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", `
+			pub contract HelloWorld {
+				pub var A: String
+				pub fun hello(): String { return self.A }
+				pub init() { self.A = "Hello" }
+			}`),
+			client.Var("address", addr1),
+			client.Var("update", true),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Empty(t, deployResp.CreateContractDeployment.Errors)
+		assert.Equal(t, "flow.AccountContractUpdated", deployResp.CreateContractDeployment.Events[0].Type)
+
+		var historyResp GetProjectHistoryResponse
+		err = c.Post(
+			QueryGetProjectHistory,
+			&historyResp,
+			client.Var("projectId", project.ID),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Len(t, historyResp.Project.TransactionExecutions, 1)
+		assert.Len(t, historyResp.Project.ContractDeployments, 2)
+	})
+
+	t.Run("incompatible update reports program errors", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var deployResp CreateContractDeploymentResponse
+		err := c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", contract),
+			client.Var("address", addr1),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		err = c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", `
+			pub contract HelloWorld {
+				pub var A: Int
+				pub init() { self.A = 1 }
+			}`),
+			client.Var("address", addr1),
+			client.Var("update", true),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Len(t, deployResp.CreateContractDeployment.Errors, 1)
+		assert.Contains(t, deployResp.CreateContractDeployment.Errors[0].Message, "mismatching field `A`")
+
+		var historyResp GetProjectHistoryResponse
+		err = c.Post(
+			QueryGetProjectHistory,
+			&historyResp,
+			client.Var("projectId", project.ID),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Len(t, historyResp.Project.ContractDeployments, 1)
+	})
+}
+
 func TestContractInteraction(t *testing.T) {
 	c := newClient()
 
@@ -209,6 +209,71 @@ func TestContractTemplates(t *testing.T) {
 		assert.Equal(t, respB.UpdateContractTemplate.Script, respC.UpdateContractTemplate.Script)
 	})
 
+	t.Run("Update contract template with formatting", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var respA CreateContractTemplateResponse
+
+		err := c.Post(
+			MutationCreateContractTemplate,
+			&respA,
+			client.Var("projectId", project.ID),
+			client.Var("title", "foo"),
+			client.Var("script", "apple"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		templateID := respA.CreateContractTemplate.ID
+
+		var respB UpdateContractTemplateResponse
+
+		err = c.Post(
+			MutationUpdateContractTemplateFormattedScript,
+			&respB,
+			client.Var("projectId", project.ID),
+			client.Var("templateId", templateID),
+			client.Var("script", "pub contract Foo {  pub fun bar(): Int { return 1+2 } }"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, "pub contract Foo {\n    pub fun bar(): Int {\n        return 1 + 2\n    }\n}\n", respB.UpdateContractTemplate.Script)
+		assert.Nil(t, respB.UpdateContractTemplate.FormatError)
+
+		// the comments are kept and the declarations without comments are formatted
+		var respComments UpdateContractTemplateResponse
+		err = c.Post(
+			MutationUpdateContractTemplateFormattedScript,
+			&respComments,
+			client.Var("projectId", project.ID),
+			client.Var("templateId", templateID),
+			client.Var("script", "// Foo counts\npub contract Foo {  pub fun bar(): Int { return 1+2 } }"),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "// Foo counts\npub contract Foo {\n    pub fun bar(): Int {\n        return 1 + 2\n    }\n}\n", respComments.UpdateContractTemplate.Script)
+		assert.Nil(t, respComments.UpdateContractTemplate.FormatError)
+
+		// the invalid code is saved unformatted, so the edit is not lost
+		const invalid = "pub contract Foo {"
+
+		var respInvalid UpdateContractTemplateResponse
+		err = c.Post(
+			MutationUpdateContractTemplateFormattedScript,
+			&respInvalid,
+			client.Var("projectId", project.ID),
+			client.Var("templateId", templateID),
+			client.Var("script", invalid),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, invalid, respInvalid.UpdateContractTemplate.Script)
+		assert.NotNil(t, respInvalid.UpdateContractTemplate.FormatError)
+	})
+
 	t.Run("Test same contract ordering after updating", func(t *testing.T) {
 		c := newClient()
 
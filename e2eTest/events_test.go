@@ -0,0 +1,187 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2eTest
+
+import (
+	"github.com/dapperlabs/flow-playground-api/e2eTest/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEvents(t *testing.T) {
+	const contract = `
+	pub contract HelloWorld {
+		pub event Greeted(name: String)
+
+		pub fun greet(_ name: String) {
+			emit Greeted(name: name)
+		}
+
+		pub init() {}
+	}`
+
+	const transaction = `
+	import HelloWorld from 0x05
+
+	transaction {
+		execute {
+			HelloWorld.greet("Alice")
+			HelloWorld.greet("Bob")
+		}
+	}`
+
+	const greetedType = "A.0000000000000005.HelloWorld.Greeted"
+
+	setup := func(t *testing.T, c *Client) Project {
+		project := createProject(t, c)
+
+		var deployResp CreateContractDeploymentResponse
+		err := c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", contract),
+			client.Var("address", addr1),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			var txResp CreateTransactionExecutionResponse
+			err = c.Post(
+				MutationCreateTransactionExecution,
+				&txResp,
+				client.Var("projectId", project.ID),
+				client.Var("script", transaction),
+				client.AddCookie(c.SessionCookie()),
+			)
+			require.NoError(t, err)
+			require.Empty(t, txResp.CreateTransactionExecution.Errors)
+		}
+
+		return project
+	}
+
+	t.Run("Filter events by type prefix and address", func(t *testing.T) {
+		c := newClient()
+		project := setup(t, c)
+
+		var resp GetEventsResponse
+		err := c.Post(
+			QueryGetEvents,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("type", "A.0000000000000005.HelloWorld"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 4, resp.Events.TotalCount)
+		require.Len(t, resp.Events.Events, 4)
+
+		first := resp.Events.Events[0]
+		assert.Equal(t, greetedType, first.Type)
+		assert.Equal(t, "{\"value\":\"Alice\",\"type\":\"String\"}\n", first.Values[0])
+		assert.Equal(t, addr1, *first.Address)
+		assert.Equal(t, InitBlockHeight+2, first.BlockHeight)
+		assert.Len(t, first.TransactionID, 64)
+		assert.Equal(t, 0, first.EventIndex)
+
+		err = c.Post(
+			QueryGetEvents,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("address", addr1),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 4, resp.Events.TotalCount)
+
+		err = c.Post(
+			QueryGetEvents,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("type", "flow.AccountContractAdded"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, resp.Events.TotalCount)
+		assert.Nil(t, resp.Events.Events[0].Address)
+	})
+
+	t.Run("Filter events by block height and paginate", func(t *testing.T) {
+		c := newClient()
+		project := setup(t, c)
+
+		var resp GetEventsResponse
+		err := c.Post(
+			QueryGetEvents,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("type", greetedType),
+			client.Var("fromHeight", InitBlockHeight+3),
+			client.Var("toHeight", InitBlockHeight+3),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.Events.TotalCount)
+		assert.Equal(t, InitBlockHeight+3, resp.Events.Events[0].BlockHeight)
+
+		err = c.Post(
+			QueryGetEvents,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("type", greetedType),
+			client.Var("offset", 1),
+			client.Var("limit", 2),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 4, resp.Events.TotalCount)
+		require.Len(t, resp.Events.Events, 2)
+		assert.Equal(t, "{\"value\":\"Bob\",\"type\":\"String\"}\n", resp.Events.Events[0].Values[0])
+		assert.Equal(t, "{\"value\":\"Alice\",\"type\":\"String\"}\n", resp.Events.Events[1].Values[0])
+
+		err = c.Post(
+			QueryGetEvents,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("limit", 0),
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("Events are removed on project reset", func(t *testing.T) {
+		c := newClient()
+		project := setup(t, c)
+
+		var resetResp ResetProjectResponse
+		err := c.Post(
+			MutationResetProjectState,
+			&resetResp,
+			client.Var("projectId", project.ID),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		var resp GetEventsResponse
+		err = c.Post(
+			QueryGetEvents,
+			&resp,
+			client.Var("projectId", project.ID),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 0, resp.Events.TotalCount)
+	})
+}
@@ -0,0 +1,122 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2eTest
+
+import (
+	"github.com/dapperlabs/flow-playground-api/e2eTest/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExecutionBatch(t *testing.T) {
+	const contract = `
+		pub contract HelloWorld {
+			pub var A: String
+			pub init() { self.A = "HelloWorld" }
+		}`
+
+	const transaction = `
+		import HelloWorld from 0x05
+		transaction {
+			prepare(signer: AuthAccount) {}
+			execute {
+				log(HelloWorld.A)
+			}
+		}`
+
+	t.Run("Create execution batch", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var resp CreateExecutionBatchResponse
+		err := c.Post(
+			MutationCreateExecutionBatch,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("steps", []map[string]any{
+				{"contractDeployment": map[string]any{"script": contract, "address": addr1}},
+				{"transactionExecution": map[string]any{"script": transaction, "signers": []string{addr2}}},
+				{"scriptExecution": map[string]any{"script": `
+					import HelloWorld from 0x05
+					pub fun main(): String { return HelloWorld.A }`,
+				}},
+			}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Len(t, resp.CreateExecutionBatch, 3)
+
+		require.NotNil(t, resp.CreateExecutionBatch[0].ContractDeployment)
+		assert.Equal(t, "HelloWorld", resp.CreateExecutionBatch[0].ContractDeployment.Title)
+		assert.Equal(t, InitBlockHeight+1, resp.CreateExecutionBatch[0].ContractDeployment.BlockHeight)
+
+		require.NotNil(t, resp.CreateExecutionBatch[1].TransactionExecution)
+		assert.Empty(t, resp.CreateExecutionBatch[1].TransactionExecution.Errors)
+		assert.Contains(t, resp.CreateExecutionBatch[1].TransactionExecution.Logs[0], "HelloWorld")
+
+		require.NotNil(t, resp.CreateExecutionBatch[2].ScriptExecution)
+		assert.Equal(t, `"HelloWorld"`, resp.CreateExecutionBatch[2].ScriptExecution.Value)
+	})
+
+	t.Run("Failed execution batch is rolled back", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var resp CreateExecutionBatchResponse
+		err := c.Post(
+			MutationCreateExecutionBatch,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("steps", []map[string]any{
+				{"contractDeployment": map[string]any{"script": contract, "address": addr1}},
+				{"transactionExecution": map[string]any{"script": `transaction { execute { panic("oh no") } }`}},
+			}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "panic: oh no")
+
+		var accResp GetAccountResponse
+		err = c.Post(
+			QueryGetAccount,
+			&accResp,
+			client.Var("address", addr1),
+			client.Var("projectId", project.ID),
+		)
+		require.NoError(t, err)
+		assert.Empty(t, accResp.Account.DeployedContracts)
+	})
+
+	t.Run("Create execution batch without permission", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var resp CreateExecutionBatchResponse
+		err := c.Post(
+			MutationCreateExecutionBatch,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("steps", []map[string]any{
+				{"contractDeployment": map[string]any{"script": contract, "address": addr1}},
+			}),
+		)
+		require.Error(t, err)
+	})
+}
@@ -48,6 +48,7 @@ type Client struct {
 	sessionCookie *http.Cookie
 	projects      *blockchain.Projects
 	store         storage.Store
+	auth          *auth.Authenticator
 }
 
 func (c *Client) Post(query string, response interface{}, options ...client.Option) error {
@@ -114,6 +115,7 @@ func newClient() *Client {
 	c := newClientWithResolver(resolver)
 	c.store = store
 	c.projects = chain
+	c.auth = authenticator
 	return c
 }
 
@@ -0,0 +1,339 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2eTest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	legacyauth "github.com/dapperlabs/flow-playground-api/auth/legacy"
+	"github.com/dapperlabs/flow-playground-api/e2eTest/client"
+	"github.com/dapperlabs/flow-playground-api/languageserver"
+	"github.com/dapperlabs/flow-playground-api/middleware/httpcontext"
+	"github.com/go-chi/chi"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type lspMessage struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+}
+
+type lspConn struct {
+	t    *testing.T
+	conn *websocket.Conn
+	id   int
+}
+
+func (c *lspConn) notify(method string, params interface{}) {
+	err := c.conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params})
+	require.NoError(c.t, err)
+}
+
+func (c *lspConn) request(method string, params interface{}, result interface{}) {
+	c.id++
+	err := c.conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": c.id, "method": method, "params": params})
+	require.NoError(c.t, err)
+
+	msg := c.next(func(msg lspMessage) bool { return msg.ID != nil && *msg.ID == c.id })
+	require.NoError(c.t, json.Unmarshal(msg.Result, result))
+}
+
+func (c *lspConn) diagnostics() []languageserver.Diagnostic {
+	msg := c.next(func(msg lspMessage) bool { return msg.Method == "textDocument/publishDiagnostics" })
+
+	var params languageserver.PublishDiagnosticsParams
+	require.NoError(c.t, json.Unmarshal(msg.Params, &params))
+	return params.Diagnostics
+}
+
+func (c *lspConn) next(match func(msg lspMessage) bool) lspMessage {
+	for {
+		var msg lspMessage
+		require.NoError(c.t, c.conn.ReadJSON(&msg))
+		if match(msg) {
+			return msg
+		}
+	}
+}
+
+func newLanguageServer(c *Client) *httptest.Server {
+	router := chi.NewRouter()
+	router.Use(httpcontext.Middleware())
+	router.Use(legacyauth.MockProjectSessions())
+	router.Handle("/", languageserver.NewHandler(c.store, c.projects, c.auth, nil))
+
+	return httptest.NewServer(router)
+}
+
+func dialLanguageServer(t *testing.T, c *Client, server *httptest.Server, project Project) *lspConn {
+	header := http.Header{}
+	header.Add("Cookie", c.SessionCookie().String())
+
+	conn, _, err := websocket.DefaultDialer.Dial(
+		"ws"+strings.TrimPrefix(server.URL, "http")+"?projectId="+project.ID,
+		header,
+	)
+	require.NoError(t, err)
+
+	return &lspConn{t: t, conn: conn}
+}
+
+func TestLanguageServer(t *testing.T) {
+	c := newClient()
+
+	project := createProject(t, c)
+
+	var templateResp CreateContractTemplateResponse
+	err := c.Post(
+		MutationCreateContractTemplate,
+		&templateResp,
+		client.Var("projectId", project.ID),
+		client.Var("title", "greeter"),
+		client.Var("script", `pub contract Greeter { pub fun hello(name: String): String { return "hi ".concat(name) } }`),
+		client.AddCookie(c.SessionCookie()),
+	)
+	require.NoError(t, err)
+
+	server := newLanguageServer(c)
+	defer server.Close()
+
+	lsp := dialLanguageServer(t, c, server, project)
+	defer lsp.conn.Close()
+
+	var initResult languageserver.InitializeResult
+	lsp.request("initialize", map[string]interface{}{}, &initResult)
+	assert.True(t, initResult.Capabilities.HoverProvider)
+
+	const uri = "file:///script.cdc"
+
+	lsp.notify("textDocument/didOpen", languageserver.DidOpenTextDocumentParams{
+		TextDocument: languageserver.TextDocumentItem{
+			URI:  uri,
+			Text: `pub fun main(): Int { return "foo" }`,
+		},
+	})
+	diagnostics := lsp.diagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Contains(t, diagnostics[0].Message, "mismatched types")
+	assert.Equal(t, 0, diagnostics[0].Range.Start.Line)
+
+	// the string import is resolved from the contract file, which isn't deployed
+	const code = `import "Greeter"
+pub fun main(): String {
+  let greeting = Greeter.hello(name: "Flow")
+  return greeting
+}`
+
+	lsp.notify("textDocument/didChange", languageserver.DidChangeTextDocumentParams{
+		TextDocument:   languageserver.TextDocumentIdentifier{URI: uri},
+		ContentChanges: []languageserver.TextDocumentContentChangeEvent{{Text: code}},
+	})
+	assert.Empty(t, lsp.diagnostics())
+
+	position := func(line, character int) languageserver.TextDocumentPositionParams {
+		return languageserver.TextDocumentPositionParams{
+			TextDocument: languageserver.TextDocumentIdentifier{URI: uri},
+			Position:     languageserver.Position{Line: line, Character: character},
+		}
+	}
+
+	var hover languageserver.Hover
+	lsp.request("textDocument/hover", position(3, 10), &hover)
+	assert.Contains(t, hover.Contents.Value, "greeting: String")
+
+	var location languageserver.Location
+	lsp.request("textDocument/definition", position(3, 10), &location)
+	assert.Equal(t, uri, location.URI)
+	assert.Equal(t, 2, location.Range.Start.Line)
+	assert.Equal(t, 6, location.Range.Start.Character)
+
+	var signature languageserver.SignatureHelp
+	lsp.request("textDocument/signatureHelp", position(2, 32), &signature)
+	require.Len(t, signature.Signatures, 1)
+	assert.Equal(t, "(name: String): String", signature.Signatures[0].Label)
+
+	// the members are completed from the last checked version while the code can't be parsed
+	lsp.notify("textDocument/didChange", languageserver.DidChangeTextDocumentParams{
+		TextDocument: languageserver.TextDocumentIdentifier{URI: uri},
+		ContentChanges: []languageserver.TextDocumentContentChangeEvent{{
+			Text: strings.Replace(code, `Greeter.hello(name: "Flow")`, "Greeter.", 1),
+		}},
+	})
+	assert.NotEmpty(t, lsp.diagnostics())
+
+	var items []languageserver.CompletionItem
+	lsp.request("textDocument/completion", position(2, 25), &items)
+
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.Label
+	}
+	assert.Contains(t, labels, "hello")
+}
+
+func TestLanguageServerAccess(t *testing.T) {
+	c := newClient()
+
+	project := createProject(t, c)
+
+	server := newLanguageServer(c)
+	defer server.Close()
+
+	t.Run("without access", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(
+			"ws"+strings.TrimPrefix(server.URL, "http")+"?projectId="+project.ID,
+			nil,
+		)
+		require.ErrorIs(t, err, websocket.ErrBadHandshake)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("with access", func(t *testing.T) {
+		lsp := dialLanguageServer(t, c, server, project)
+		defer lsp.conn.Close()
+
+		var initResult languageserver.InitializeResult
+		lsp.request("initialize", map[string]interface{}{}, &initResult)
+		assert.True(t, initResult.Capabilities.HoverProvider)
+	})
+}
+
+func TestLanguageServerChanges(t *testing.T) {
+	c := newClient()
+
+	project := createProject(t, c)
+
+	server := newLanguageServer(c)
+	defer server.Close()
+
+	lsp := dialLanguageServer(t, c, server, project)
+	defer lsp.conn.Close()
+
+	const uri = "file:///script.cdc"
+
+	lsp.notify("textDocument/didOpen", languageserver.DidOpenTextDocumentParams{
+		TextDocument: languageserver.TextDocumentItem{
+			URI:  uri,
+			Text: `pub fun main(): Int { return 1 }`,
+		},
+	})
+	assert.Empty(t, lsp.diagnostics())
+
+	// quick changes are checked together, so only the latest text is reported
+	for _, code := range []string{
+		`pub fun main(): Int { return "a" }`,
+		`pub fun main(): Int { return "ab" }`,
+		`pub fun main(): Int { return 2 }`,
+	} {
+		lsp.notify("textDocument/didChange", languageserver.DidChangeTextDocumentParams{
+			TextDocument:   languageserver.TextDocumentIdentifier{URI: uri},
+			ContentChanges: []languageserver.TextDocumentContentChangeEvent{{Text: code}},
+		})
+	}
+	assert.Empty(t, lsp.diagnostics())
+
+	// messages over the read limit close the connection
+	err := lsp.conn.WriteMessage(websocket.TextMessage, make([]byte, 2<<20))
+	require.NoError(t, err)
+	_, _, err = lsp.conn.ReadMessage()
+	require.Error(t, err)
+}
+
+func TestLanguageServerUnicode(t *testing.T) {
+	c := newClient()
+
+	project := createProject(t, c)
+
+	server := newLanguageServer(c)
+	defer server.Close()
+
+	lsp := dialLanguageServer(t, c, server, project)
+	defer lsp.conn.Close()
+
+	const uri = "file:///script.cdc"
+
+	// the string is encoded in 4 runes and 5 UTF-16 code units, so the positions after it differ
+	const code = `pub fun main(): Int {
+  let s = "é👋"; let n = s.length
+  return n
+}`
+
+	lsp.notify("textDocument/didOpen", languageserver.DidOpenTextDocumentParams{
+		TextDocument: languageserver.TextDocumentItem{URI: uri, Text: code},
+	})
+	assert.Empty(t, lsp.diagnostics())
+
+	position := func(line, character int) languageserver.TextDocumentPositionParams {
+		return languageserver.TextDocumentPositionParams{
+			TextDocument: languageserver.TextDocumentIdentifier{URI: uri},
+			Position:     languageserver.Position{Line: line, Character: character},
+		}
+	}
+
+	var hover languageserver.Hover
+	lsp.request("textDocument/hover", position(1, 25), &hover)
+	assert.Contains(t, hover.Contents.Value, "s: String")
+	require.NotNil(t, hover.Range)
+	assert.Equal(t, 25, hover.Range.Start.Character)
+	assert.Equal(t, 26, hover.Range.End.Character)
+
+	var location languageserver.Location
+	lsp.request("textDocument/definition", position(2, 9), &location)
+	assert.Equal(t, 1, location.Range.Start.Line)
+	assert.Equal(t, 21, location.Range.Start.Character)
+	assert.Equal(t, 22, location.Range.End.Character)
+
+	lsp.notify("textDocument/didChange", languageserver.DidChangeTextDocumentParams{
+		TextDocument: languageserver.TextDocumentIdentifier{URI: uri},
+		ContentChanges: []languageserver.TextDocumentContentChangeEvent{{
+			Text: strings.Replace(code, "s.length", "s.", 1),
+		}},
+	})
+	assert.NotEmpty(t, lsp.diagnostics())
+
+	var items []languageserver.CompletionItem
+	lsp.request("textDocument/completion", position(1, 27), &items)
+
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.Label
+	}
+	assert.Contains(t, labels, "length")
+
+	lsp.notify("textDocument/didChange", languageserver.DidChangeTextDocumentParams{
+		TextDocument: languageserver.TextDocumentIdentifier{URI: uri},
+		ContentChanges: []languageserver.TextDocumentContentChangeEvent{{
+			Text: "pub fun main(): Int {\n  let s = \"é👋\"; return s\n}",
+		}},
+	})
+	diagnostics := lsp.diagnostics()
+	require.Len(t, diagnostics, 1)
+	assert.Contains(t, diagnostics[0].Message, "mismatched types")
+	assert.Equal(t, 1, diagnostics[0].Range.Start.Line)
+	assert.Equal(t, 24, diagnostics[0].Range.Start.Character)
+	assert.Equal(t, 25, diagnostics[0].Range.End.Character)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/dapperlabs/flow-playground-api/e2eTest/client"
 	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/dapperlabs/flow-playground-api/server/config"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -257,6 +258,269 @@ func TestProjects(t *testing.T) {
 		assert.Equal(t, project.ID, resetResp.ResetProjectState)
 	})
 
+	t.Run("Rollback project state", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		const contract = `
+		pub contract HelloWorld {
+			pub var A: String
+			pub init() { self.A = "HelloWorld" }
+		}`
+
+		var deployResp CreateContractDeploymentResponse
+		err := c.Post(
+			MutationCreateContractDeployment,
+			&deployResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", contract),
+			client.Var("address", addr1),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		const script = "transaction { prepare(signer: AuthAccount) { AuthAccount(payer: signer) } }"
+
+		for i := 0; i < 2; i++ {
+			var txResp CreateTransactionExecutionResponse
+			err = c.Post(
+				MutationCreateTransactionExecution,
+				&txResp,
+				client.Var("projectId", project.ID),
+				client.Var("script", script),
+				client.Var("signers", []string{addr1}),
+				client.AddCookie(c.SessionCookie()),
+			)
+			require.NoError(t, err)
+			require.Empty(t, txResp.CreateTransactionExecution.Errors)
+		}
+
+		var rollbackResp RollbackProjectResponse
+		err = c.Post(
+			MutationRollbackProject,
+			&rollbackResp,
+			client.Var("projectId", project.ID),
+			client.Var("blockHeight", deployResp.CreateContractDeployment.BlockHeight),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, project.ID, rollbackResp.RollbackProject.ID)
+		assert.Empty(t, rollbackResp.RollbackProject.TransactionExecutions)
+		require.Len(t, rollbackResp.RollbackProject.ContractDeployments, 1)
+		assert.Equal(t,
+			deployResp.CreateContractDeployment.BlockHeight,
+			rollbackResp.RollbackProject.ContractDeployments[0].BlockHeight,
+		)
+
+		var txResp CreateTransactionExecutionResponse
+		err = c.Post(
+			MutationCreateTransactionExecution,
+			&txResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("signers", []string{addr1}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Len(t, txResp.CreateTransactionExecution.Events, 6)
+
+		// rolled back accounts are created again
+		event := txResp.CreateTransactionExecution.Events[5]
+		assert.Equal(t, "flow.AccountCreated", event.Type)
+		assert.JSONEq(t,
+			`{"type":"Address","value":"0x000000000000000a"}`,
+			event.Values[0],
+		)
+	})
+
+	t.Run("Rollback project to invalid block height", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		var rollbackResp RollbackProjectResponse
+		err := c.Post(
+			MutationRollbackProject,
+			&rollbackResp,
+			client.Var("projectId", project.ID),
+			client.Var("blockHeight", 100),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "block height must be between")
+	})
+
+	t.Run("Create project with emulator configuration", func(t *testing.T) {
+		c := newClient()
+
+		var resp CreateProjectWithEmulatorConfigResponse
+		err := c.Post(
+			MutationCreateProjectWithEmulatorConfig,
+			&resp,
+			client.Var("title", "foo"),
+			client.Var("seed", 42),
+			client.Var("description", "desc"),
+			client.Var("readme", "rtfm"),
+			client.Var("numberOfAccounts", 5),
+			client.Var("emulatorConfig", map[string]any{
+				"transactionFeesEnabled": true,
+				"computationLimit":       500,
+			}),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, EmulatorConfig{
+			TransactionFeesEnabled: true,
+			ComputationLimit:       500,
+		}, resp.CreateProject.EmulatorConfig)
+	})
+
+	t.Run("Create project with default emulator configuration", func(t *testing.T) {
+		c := newClient()
+
+		var resp CreateProjectWithEmulatorConfigResponse
+		err := c.Post(
+			MutationCreateProjectWithEmulatorConfig,
+			&resp,
+			client.Var("title", "foo"),
+			client.Var("seed", 42),
+			client.Var("description", "desc"),
+			client.Var("readme", "rtfm"),
+			client.Var("numberOfAccounts", 5),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, EmulatorConfig{
+			ComputationLimit: model.DefaultComputationLimit,
+		}, resp.CreateProject.EmulatorConfig)
+	})
+
+	t.Run("Create project with invalid computation limit", func(t *testing.T) {
+		c := newClient()
+
+		var resp CreateProjectWithEmulatorConfigResponse
+		err := c.Post(
+			MutationCreateProjectWithEmulatorConfig,
+			&resp,
+			client.Var("title", "foo"),
+			client.Var("seed", 42),
+			client.Var("description", "desc"),
+			client.Var("readme", "rtfm"),
+			client.Var("numberOfAccounts", 5),
+			client.Var("emulatorConfig", map[string]any{
+				"computationLimit": 0,
+			}),
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("Create project with too high computation limit", func(t *testing.T) {
+		c := newClient()
+
+		var resp CreateProjectWithEmulatorConfigResponse
+		err := c.Post(
+			MutationCreateProjectWithEmulatorConfig,
+			&resp,
+			client.Var("title", "foo"),
+			client.Var("seed", 42),
+			client.Var("description", "desc"),
+			client.Var("readme", "rtfm"),
+			client.Var("numberOfAccounts", 5),
+			client.Var("emulatorConfig", map[string]any{
+				"computationLimit": config.Playground().MaxComputationLimit + 1,
+			}),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "computation limit can't be greater than")
+	})
+
+	t.Run("Update project emulator configuration", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var resp UpdateProjectEmulatorConfigResponse
+		err := c.Post(
+			MutationUpdateProjectEmulatorConfig,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("emulatorConfig", map[string]any{
+				"computationLimit": 50,
+			}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, EmulatorConfig{ComputationLimit: 50}, resp.UpdateProject.EmulatorConfig)
+
+		var txResp CreateTransactionExecutionResponse
+		err = c.Post(
+			MutationCreateTransactionExecution,
+			&txResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", `
+				transaction {
+					execute {
+						var i = 0
+						while i < 100_000 {
+							i = i + 1
+						}
+					}
+				}`),
+			client.Var("signers", []string{}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Len(t, txResp.CreateTransactionExecution.Errors, 1)
+		assert.Contains(t, txResp.CreateTransactionExecution.Errors[0].Message, "computation exceeds limit (50)")
+	})
+
+	t.Run("Update emulator configuration of used project", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var txResp CreateTransactionExecutionResponse
+		err := c.Post(
+			MutationCreateTransactionExecution,
+			&txResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", `transaction {}`),
+			client.Var("signers", []string{}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		updateConfig := func() error {
+			var resp UpdateProjectEmulatorConfigResponse
+			return c.Post(
+				MutationUpdateProjectEmulatorConfig,
+				&resp,
+				client.Var("projectId", project.ID),
+				client.Var("emulatorConfig", map[string]any{
+					"computationLimit": 50,
+				}),
+				client.AddCookie(c.SessionCookie()),
+			)
+		}
+
+		err = updateConfig()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reset the project first")
+
+		var resetResp ResetProjectResponse
+		err = c.Post(
+			MutationResetProjectState,
+			&resetResp,
+			client.Var("projectId", project.ID),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		err = updateConfig()
+		require.NoError(t, err)
+	})
+
 	t.Run("Maximum projects limit", func(t *testing.T) {
 		const MaxProjectsLimit = 50
 		const additionalAttempts = 5 // Try to create projects over the limit
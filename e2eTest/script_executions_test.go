@@ -211,5 +211,173 @@ func TestScriptExecutions(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, resp.CreateScriptExecution.Logs[0], "hello")
 		assert.Contains(t, resp.CreateScriptExecution.Logs[1], "test")
+
+		require.Len(t, resp.CreateScriptExecution.LogEntries, 2)
+		assert.Equal(t, 2, resp.CreateScriptExecution.LogEntries[1].Line)
+		assert.Contains(t, resp.CreateScriptExecution.LogEntries[1].Message, "test")
+		assert.Nil(t, resp.CreateScriptExecution.LogEntries[1].TransactionID)
 	})
+
+	t.Run("at block height", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var txResp CreateTransactionExecutionResponse
+		err := c.Post(
+			MutationCreateTransactionExecution,
+			&txResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", `
+			transaction {
+				prepare(signer: AuthAccount) {
+					signer.save(42, to: /storage/answer)
+				}
+			}`),
+			client.Var("signers", []string{addr1}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Empty(t, txResp.CreateTransactionExecution.Errors)
+
+		const script = `
+		pub fun main(): Int? {
+			return getAuthAccount(0x05).copy<Int>(from: /storage/answer)
+		}`
+
+		var resp CreateScriptExecutionResponse
+		err = c.Post(
+			MutationCreateScriptExecution,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("blockHeight", InitBlockHeight),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Empty(t, resp.CreateScriptExecution.Errors)
+		assert.Equal(t, "nil", resp.CreateScriptExecution.Value)
+		assert.Equal(t, InitBlockHeight, *resp.CreateScriptExecution.BlockHeight)
+
+		err = c.Post(
+			MutationCreateScriptExecution,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "42", resp.CreateScriptExecution.Value)
+		assert.Nil(t, resp.CreateScriptExecution.BlockHeight)
+
+		err = c.Post(
+			MutationCreateScriptExecution,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("blockHeight", InitBlockHeight+2),
+			client.AddCookie(c.SessionCookie()),
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("plain and named arguments", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		const script = `
+		pub fun main(amount: UFix64, names: [String]): String {
+			return amount.toString().concat(names[0])
+		}`
+
+		var resp CreateScriptExecutionResponse
+		err := c.Post(
+			MutationCreateScriptExecution,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("arguments", []string{"1.5", `["Alice"]`}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Empty(t, resp.CreateScriptExecution.Errors)
+		assert.Equal(t, `"1.50000000Alice"`, resp.CreateScriptExecution.Value)
+
+		err = c.Post(
+			MutationCreateScriptExecution,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("namedArguments", []map[string]string{
+				{"name": "names", "value": `["Bob"]`},
+				{"name": "amount", "value": "2"},
+			}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Empty(t, resp.CreateScriptExecution.Errors)
+		assert.Equal(t, `"2.00000000Bob"`, resp.CreateScriptExecution.Value)
+
+		err = c.Post(
+			MutationCreateScriptExecution,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("arguments", []string{"abc", `["Alice"]`}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "parameter amount of type UFix64")
+	})
+}
+
+func TestCheckCode(t *testing.T) {
+	c := newClient()
+
+	project := createProject(t, c)
+
+	var resp CheckCodeResponse
+	err := c.Post(
+		QueryCheckCode,
+		&resp,
+		client.Var("projectId", project.ID),
+		client.Var("script", `pub fun main(): Int { return "foo" }`),
+		client.Var("kind", "SCRIPT"),
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.CheckCode, 1)
+	assert.Contains(t, resp.CheckCode[0].Message, "mismatched types")
+	require.NotNil(t, resp.CheckCode[0].StartPosition)
+	assert.Equal(t, 29, resp.CheckCode[0].StartPosition.Column)
+
+	err = c.Post(
+		QueryCheckCode,
+		&resp,
+		client.Var("projectId", project.ID),
+		client.Var("script", `pub fun main(): Int { return 42 }`),
+		client.Var("kind", "SCRIPT"),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, resp.CheckCode)
+}
+
+func TestFormatCode(t *testing.T) {
+	c := newClient()
+
+	var resp FormatCodeResponse
+	err := c.Post(
+		QueryFormatCode,
+		&resp,
+		client.Var("script", `pub fun main(): [Int] {return [1,2]}`),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "pub fun main(): [Int] {\n    return [1, 2]\n}\n", resp.FormatCode)
+
+	err = c.Post(
+		QueryFormatCode,
+		&resp,
+		client.Var("script", `pub fun main(): [Int] {`),
+	)
+	assert.Error(t, err)
 }
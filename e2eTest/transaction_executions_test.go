@@ -86,7 +86,13 @@ func TestTransactionExecutions(t *testing.T) {
 		assert.Empty(t, resp.CreateTransactionExecution.Errors)
 
 		assert.Contains(t, resp.CreateTransactionExecution.Logs[0], `Hello, World!`)
+		require.NotEmpty(t, resp.CreateTransactionExecution.LogEntries)
+		require.NotNil(t, resp.CreateTransactionExecution.LogEntries[0].TransactionID)
+		assert.Equal(t, resp.CreateTransactionExecution.TransactionID, *resp.CreateTransactionExecution.LogEntries[0].TransactionID)
 		assert.Equal(t, script, resp.CreateTransactionExecution.Script)
+		assert.Len(t, resp.CreateTransactionExecution.TransactionID, 64)
+		assert.Equal(t, "SEALED", resp.CreateTransactionExecution.Status)
+		assert.Equal(t, "0.00000000", resp.CreateTransactionExecution.Fee)
 	})
 
 	t.Run("Multi-signer execution", func(t *testing.T) {
@@ -121,6 +127,36 @@ func TestTransactionExecutions(t *testing.T) {
 		assert.Equal(t, script, resp.CreateTransactionExecution.Script)
 	})
 
+	t.Run("Execution with payer and proposer", func(t *testing.T) {
+		c := newClient()
+
+		project := createProject(t, c)
+
+		var resp CreateTransactionExecutionResponse
+
+		const script = `
+		transaction {
+			prepare(acct: AuthAccount) {}
+		}`
+
+		err := c.Post(
+			MutationCreateTransactionExecution,
+			&resp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("signers", []string{addr2}),
+			client.Var("payer", addr3),
+			client.Var("proposer", addr4),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		assert.Empty(t, resp.CreateTransactionExecution.Errors)
+		assert.Equal(t, []string{addr2}, resp.CreateTransactionExecution.Signers)
+		assert.Equal(t, addr3, resp.CreateTransactionExecution.Payer)
+		assert.Equal(t, addr4, resp.CreateTransactionExecution.Proposer)
+	})
+
 	t.Run("Multiple executions", func(t *testing.T) {
 		c := newClient()
 
@@ -235,6 +271,73 @@ func TestTransactionExecutions(t *testing.T) {
 		)
 	})
 
+	t.Run("Simulate execution", func(t *testing.T) {
+		c := newClient()
+		project := createProject(t, c)
+
+		const script = `
+			transaction {
+				prepare(signer: AuthAccount) {
+					AuthAccount(payer: signer)
+					signer.save("Hello", to: /storage/greeting)
+				}
+				execute {
+					log("Hello, World!")
+				}
+			}`
+
+		var simResp SimulateTransactionResponse
+		err := c.Post(
+			MutationSimulateTransaction,
+			&simResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("signers", []string{addr2}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+
+		simulation := simResp.SimulateTransaction
+		require.Empty(t, simulation.Errors)
+		assert.Contains(t, simulation.Logs[0], "Hello, World!")
+		require.Len(t, simulation.Events, 6)
+		assert.JSONEq(t,
+			`{"type":"Address","value":"0x000000000000000a"}`,
+			simulation.Events[5].Values[0],
+		)
+		// simulated accounts include the account created by the transaction
+		require.Len(t, simulation.Accounts, initAccounts+1)
+		assert.Contains(t, simulation.Accounts[1].State, "greeting")
+
+		// simulation must not change the project state
+		var accResp GetAccountResponse
+		err = c.Post(
+			QueryGetAccount,
+			&accResp,
+			client.Var("address", addr2),
+			client.Var("projectId", project.ID),
+		)
+		require.NoError(t, err)
+		assert.NotContains(t, accResp.Account.State, "greeting")
+
+		var exeResp CreateTransactionExecutionResponse
+		err = c.Post(
+			MutationCreateTransactionExecution,
+			&exeResp,
+			client.Var("projectId", project.ID),
+			client.Var("script", script),
+			client.Var("signers", []string{addr2}),
+			client.AddCookie(c.SessionCookie()),
+		)
+		require.NoError(t, err)
+		require.Empty(t, exeResp.CreateTransactionExecution.Errors)
+		require.Len(t, exeResp.CreateTransactionExecution.Events, 6)
+		assert.JSONEq(t,
+			`{"type":"Address","value":"0x000000000000000a"}`,
+			exeResp.CreateTransactionExecution.Events[5].Values[0],
+		)
+	})
+
 	t.Run("invalid (parse error)", func(t *testing.T) {
 		c := newClient()
 
@@ -368,6 +471,36 @@ func TestTransactionExecutions(t *testing.T) {
 		require.NoError(t, err)
 
 		require.Empty(t, resp.CreateTransactionExecution.Errors)
-		require.Equal(t, resp.CreateTransactionExecution.Logs, []string{`{"level":"debug","message":"Cadence log: 42"}`})
+		require.Equal(t, resp.CreateTransactionExecution.Logs, []string{`42`})
 	})
 }
+
+func TestProgramSignature(t *testing.T) {
+	c := newClient()
+
+	var resp ProgramSignatureResponse
+	err := c.Post(
+		QueryProgramSignature,
+		&resp,
+		client.Var("script", `
+			transaction(amount: UFix64) {
+				prepare(signer: AuthAccount) {}
+			}`),
+	)
+	require.NoError(t, err)
+
+	signature := resp.ProgramSignature
+	assert.Equal(t, "TRANSACTION", signature.Kind)
+	assert.Equal(t, 1, signature.Signers)
+	assert.Nil(t, signature.ReturnType)
+	require.Len(t, signature.Parameters, 1)
+	assert.Equal(t, "amount", signature.Parameters[0].Name)
+	assert.Equal(t, "UFix64", signature.Parameters[0].Type)
+
+	err = c.Post(
+		QueryProgramSignature,
+		&resp,
+		client.Var("script", `pub fun helper() {}`),
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,377 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languageserver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// hover shows the declaration kind, the type and the documentation of the identifier at the position.
+func hover(doc *document, _ string, position Position) interface{} {
+	occurrence := doc.checker.PositionInfo.Occurrences.Find(doc.semaPosition(position))
+	if occurrence == nil || occurrence.Origin == nil || occurrence.Origin.Type == nil {
+		return nil
+	}
+
+	origin := occurrence.Origin
+	signature := fmt.Sprintf(
+		"(%s) %s: %s",
+		origin.DeclarationKind.Name(),
+		doc.checkedIdentifier(occurrence.StartPos, occurrence.EndPos),
+		origin.Type.QualifiedString(),
+	)
+
+	contents := fmt.Sprintf("```cadence\n%s\n```", signature)
+	if origin.DocString != "" {
+		contents += "\n\n" + origin.DocString
+	}
+
+	occurrenceRange := Range{
+		Start: doc.lspPosition(occurrence.StartPos),
+		End:   doc.lspEndPosition(occurrence.EndPos),
+	}
+
+	return Hover{
+		Contents: MarkupContent{Kind: markupKindMarkdown, Value: contents},
+		Range:    &occurrenceRange,
+	}
+}
+
+// definition returns the location of the declaration of the identifier at the position,
+// if it's declared in the same document.
+func definition(doc *document, uri string, position Position) interface{} {
+	occurrences := doc.checker.PositionInfo.Occurrences
+
+	occurrence := occurrences.Find(doc.semaPosition(position))
+	if occurrence == nil || occurrence.Origin == nil || occurrence.Origin.StartPos == nil {
+		return nil
+	}
+	origin := occurrence.Origin
+
+	// the positions of imported declarations belong to the imported programs
+	declaration := occurrences.Find(sema.ASTToSemaPosition(*origin.StartPos))
+	if declaration == nil || declaration.Origin != origin {
+		return nil
+	}
+
+	end := *origin.StartPos
+	if origin.EndPos != nil {
+		end = *origin.EndPos
+	}
+
+	return Location{
+		URI: uri,
+		Range: Range{
+			Start: doc.lspPosition(sema.ASTToSemaPosition(*origin.StartPos)),
+			End:   doc.lspEndPosition(sema.ASTToSemaPosition(end)),
+		},
+	}
+}
+
+// completion suggests the members of the value before a dot, otherwise the declarations in scope.
+func completion(doc *document, _ string, position Position) interface{} {
+	positionInfo := doc.checker.PositionInfo
+
+	line := []rune(lineAt(doc.text, position.Line))
+	column := runeColumn(string(line), position.Character)
+	if column > 0 && column <= len(line) && line[column-1] == '.' {
+		// the identifier before the dot, which was checked before the dot was typed
+		occurrence := positionInfo.Occurrences.Find(sema.Position{
+			Line:   position.Line + 1,
+			Column: column - 2,
+		})
+		if occurrence == nil || occurrence.Origin == nil || occurrence.Origin.Type == nil {
+			return []CompletionItem{}
+		}
+
+		return memberCompletionItems(occurrence.Origin.Type)
+	}
+
+	items := make([]CompletionItem, 0)
+	seen := make(map[string]bool)
+	for _, declaration := range positionInfo.Ranges.FindAll(doc.semaPosition(position)) {
+		if seen[declaration.Identifier] {
+			continue
+		}
+		seen[declaration.Identifier] = true
+
+		item := CompletionItem{
+			Label: declaration.Identifier,
+			Kind:  completionItemKind(declaration.DeclarationKind),
+		}
+		if declaration.Type != nil {
+			item.Detail = declaration.Type.QualifiedString()
+		}
+		if declaration.DocString != "" {
+			item.Documentation = &MarkupContent{Kind: markupKindMarkdown, Value: declaration.DocString}
+		}
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+
+	return items
+}
+
+func memberCompletionItems(ty sema.Type) []CompletionItem {
+	members := ty.GetMembers()
+
+	items := make([]CompletionItem, 0, len(members))
+	for name, resolver := range members {
+		item := CompletionItem{
+			Label: name,
+			Kind:  completionItemKind(resolver.Kind),
+		}
+
+		member := resolver.Resolve(nil, name, ast.EmptyRange, func(error) {})
+		if member != nil {
+			item.Detail = member.TypeAnnotation.QualifiedString()
+			if member.DocString != "" {
+				item.Documentation = &MarkupContent{Kind: markupKindMarkdown, Value: member.DocString}
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+
+	return items
+}
+
+// signatureHelp returns the parameters of the invoked function and the parameter of the argument at the position.
+func signatureHelp(doc *document, _ string, position Position) interface{} {
+	pos := doc.semaPosition(position)
+
+	invocation := doc.checker.PositionInfo.FunctionInvocations.Find(pos)
+	if invocation == nil || invocation.FunctionType == nil {
+		return nil
+	}
+
+	functionType := invocation.FunctionType
+	parameters := make([]ParameterInformation, len(functionType.Parameters))
+	labels := make([]string, len(functionType.Parameters))
+	for i, parameter := range functionType.Parameters {
+		labels[i] = parameter.QualifiedString()
+		parameters[i] = ParameterInformation{Label: labels[i]}
+	}
+
+	activeParameter := 0
+	for _, separator := range invocation.TrailingSeparatorPositions {
+		if sema.ASTToSemaPosition(separator).Compare(pos) < 0 {
+			activeParameter++
+		}
+	}
+
+	return SignatureHelp{
+		Signatures: []SignatureInformation{{
+			Label: fmt.Sprintf(
+				"(%s): %s",
+				strings.Join(labels, ", "),
+				functionType.ReturnTypeAnnotation.QualifiedString(),
+			),
+			Parameters: parameters,
+		}},
+		ActiveParameter: activeParameter,
+	}
+}
+
+// diagnosticFromProgramError converts the error of the checked text to a diagnostic.
+func diagnosticFromProgramError(text string, programError model.ProgramError) Diagnostic {
+	diagnostic := Diagnostic{
+		Severity: diagnosticSeverityError,
+		Source:   "cadence",
+		Message:  programError.Message,
+	}
+
+	if programError.StartPosition != nil {
+		diagnostic.Range.Start = lspPosition(text, sema.Position{
+			Line:   programError.StartPosition.Line,
+			Column: programError.StartPosition.Column,
+		})
+		diagnostic.Range.End = diagnostic.Range.Start
+	}
+
+	if programError.EndPosition != nil {
+		diagnostic.Range.End = lspEndPosition(text, sema.Position{
+			Line:   programError.EndPosition.Line,
+			Column: programError.EndPosition.Column,
+		})
+	}
+
+	return diagnostic
+}
+
+// codeKind infers the kind of the code by its declarations.
+func codeKind(program *ast.Program) model.CodeKind {
+	if len(program.TransactionDeclarations()) > 0 {
+		return model.CodeKindTransaction
+	}
+
+	if contractName(program) != "" {
+		return model.CodeKindContract
+	}
+
+	return model.CodeKindScript
+}
+
+// contractName returns the name of the contract or contract interface the program declares, if any.
+func contractName(program *ast.Program) string {
+	if declaration := program.SoleContractDeclaration(); declaration != nil {
+		return declaration.Identifier.Identifier
+	}
+
+	if declaration := program.SoleContractInterfaceDeclaration(); declaration != nil {
+		return declaration.Identifier.Identifier
+	}
+
+	return ""
+}
+
+func completionItemKind(kind common.DeclarationKind) int {
+	switch kind {
+	case common.DeclarationKindFunction:
+		return completionItemKindFunction
+	case common.DeclarationKindField:
+		return completionItemKindField
+	case common.DeclarationKindContract:
+		return completionItemKindModule
+	case common.DeclarationKindStructure:
+		return completionItemKindStruct
+	case common.DeclarationKindResource:
+		return completionItemKindClass
+	case common.DeclarationKindEnum:
+		return completionItemKindEnum
+	case common.DeclarationKindEvent:
+		return completionItemKindEvent
+	case common.DeclarationKindContractInterface,
+		common.DeclarationKindStructureInterface,
+		common.DeclarationKindResourceInterface:
+		return completionItemKindInterface
+	case common.DeclarationKindInitializer, common.DeclarationKindDestructor:
+		return completionItemKindMethod
+	default:
+		return completionItemKindVariable
+	}
+}
+
+// checkedIdentifier returns the identifier between the positions of the checked text.
+func (d *document) checkedIdentifier(start, end sema.Position) string {
+	if start.Line != end.Line {
+		return ""
+	}
+
+	line := []rune(lineAt(d.checkedText, start.Line-1))
+	if start.Column < 0 || end.Column >= len(line) || start.Column > end.Column {
+		return ""
+	}
+
+	return string(line[start.Column : end.Column+1])
+}
+
+func lineAt(text string, line int) string {
+	lines := strings.Split(text, "\n")
+	if line < 0 || line >= len(lines) {
+		return ""
+	}
+
+	return strings.TrimSuffix(lines[line], "\r")
+}
+
+// The LSP positions count the characters of a line in UTF-16 code units, while the checker counts them in runes,
+// so the positions are converted using the lines of the text the checker positions refer to.
+
+// semaPosition converts the zero-based LSP position to the position of the checked text with one-based lines.
+func (d *document) semaPosition(position Position) sema.Position {
+	return sema.Position{
+		Line:   position.Line + 1,
+		Column: runeColumn(lineAt(d.checkedText, position.Line), position.Character),
+	}
+}
+
+func (d *document) lspPosition(position sema.Position) Position {
+	return lspPosition(d.checkedText, position)
+}
+
+func (d *document) lspEndPosition(position sema.Position) Position {
+	return lspEndPosition(d.checkedText, position)
+}
+
+func lspPosition(text string, position sema.Position) Position {
+	return Position{
+		Line:      position.Line - 1,
+		Character: utf16Character(lineAt(text, position.Line-1), position.Column),
+	}
+}
+
+// lspEndPosition converts the inclusive end position of the checker to the exclusive LSP end position.
+func lspEndPosition(text string, position sema.Position) Position {
+	position.Column++
+	return lspPosition(text, position)
+}
+
+// runeColumn converts the UTF-16 offset in the line to the rune column.
+func runeColumn(line string, character int) int {
+	column := 0
+	units := 0
+	for _, r := range line {
+		if units >= character {
+			return column
+		}
+		units += utf16Length(r)
+		column++
+	}
+
+	// the offsets past the end of the line are kept past its end
+	return column + character - units
+}
+
+// utf16Character converts the rune column in the line to the UTF-16 offset.
+func utf16Character(line string, column int) int {
+	units := 0
+	runes := 0
+	for _, r := range line {
+		if runes >= column {
+			return units
+		}
+		units += utf16Length(r)
+		runes++
+	}
+
+	return units + column - runes
+}
+
+// utf16Length returns the number of UTF-16 code units encoding the rune.
+func utf16Length(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
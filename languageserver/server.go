@@ -0,0 +1,423 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languageserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dapperlabs/flow-playground-api/auth"
+	"github.com/dapperlabs/flow-playground-api/blockchain"
+	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/dapperlabs/flow-playground-api/storage"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxMessageSize is the maximum size of a single message read from the connection.
+	maxMessageSize = 1 << 20
+	// checkDelay is how long the document must remain unchanged before it's checked,
+	// so the project isn't loaded on every keystroke.
+	checkDelay = 300 * time.Millisecond
+)
+
+// Handler serves the language server protocol over a WebSocket connection for a single project,
+// provided by the `projectId` query parameter. Every WebSocket message holds a single JSON-RPC message.
+//
+// The connection is only accepted for the users with access to the project, the same as the project mutations.
+type Handler struct {
+	store    storage.Store
+	projects *blockchain.Projects
+	auth     *auth.Authenticator
+	upgrader websocket.Upgrader
+}
+
+func NewHandler(
+	store storage.Store,
+	projects *blockchain.Projects,
+	authenticator *auth.Authenticator,
+	allowedOrigins []string,
+) *Handler {
+	return &Handler{
+		store:    store,
+		projects: projects,
+		auth:     authenticator,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				for _, allowed := range allowedOrigins {
+					if allowed == "*" || allowed == origin {
+						return true
+					}
+				}
+				return origin == ""
+			},
+		},
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	projectID, err := uuid.Parse(r.URL.Query().Get("projectId"))
+	if err != nil {
+		http.Error(w, "invalid project ID", http.StatusBadRequest)
+		return
+	}
+
+	var project model.Project
+	err = h.store.GetProject(projectID, &project)
+	if err != nil {
+		http.Error(w, "project not found", http.StatusNotFound)
+		return
+	}
+
+	err = h.auth.CheckProjectAccess(r.Context(), &project)
+	if err != nil {
+		http.Error(w, "not authorized", http.StatusForbidden)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxMessageSize)
+
+	s := &session{
+		handler:   h,
+		projectID: projectID,
+		conn:      conn,
+		documents: make(map[string]*document),
+	}
+	s.serve()
+}
+
+// document is an open text document along with the checker of its latest version which could be parsed.
+type document struct {
+	text        string
+	kind        model.CodeKind
+	checker     *sema.Checker
+	checkedText string
+	// pendingCheck is the scheduled check of the changed text, nil if the text was already checked
+	pendingCheck *time.Timer
+}
+
+type session struct {
+	handler   *Handler
+	projectID uuid.UUID
+	conn      *websocket.Conn
+	// mutex guards the documents and the writes to the connection, as the scheduled checks run concurrently
+	mutex     sync.Mutex
+	documents map[string]*document
+}
+
+// serve handles the messages in order until the connection is closed or the client exits.
+func (s *session) serve() {
+	defer s.close()
+
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		s.mutex.Lock()
+		exit, err := s.receive(data)
+		s.mutex.Unlock()
+		if exit || err != nil {
+			return
+		}
+	}
+}
+
+// receive handles the message and replies to it, it returns whether the client exited.
+func (s *session) receive(data []byte) (bool, error) {
+	var msg message
+	err := json.Unmarshal(data, &msg)
+	if err != nil {
+		return false, s.replyError(nil, codeParseError, err.Error())
+	}
+
+	if msg.Method == "exit" {
+		return true, nil
+	}
+
+	result, rpcErr := s.handle(msg)
+	if msg.ID == nil {
+		// notifications are not replied to
+		return false, nil
+	}
+
+	if rpcErr != nil {
+		return false, s.replyError(msg.ID, rpcErr.Code, rpcErr.Message)
+	}
+	return false, s.conn.WriteJSON(response{JSONRPC: jsonrpcVersion, ID: msg.ID, Result: result})
+}
+
+// close stops the scheduled checks of the open documents.
+func (s *session) close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, doc := range s.documents {
+		if doc.pendingCheck != nil {
+			doc.pendingCheck.Stop()
+		}
+	}
+	s.documents = make(map[string]*document)
+}
+
+func (s *session) handle(msg message) (interface{}, *responseError) {
+	switch msg.Method {
+	case "initialize":
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:      textDocumentSyncFull,
+				HoverProvider:         true,
+				DefinitionProvider:    true,
+				CompletionProvider:    CompletionOptions{TriggerCharacters: []string{"."}},
+				SignatureHelpProvider: SignatureHelpOptions{TriggerCharacters: []string{"(", ","}},
+			},
+			ServerInfo: ServerInfo{Name: "flow-playground"},
+		}, nil
+
+	case "shutdown":
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		s.documents[params.TextDocument.URI] = &document{
+			text: params.TextDocument.Text,
+			kind: model.CodeKindScript,
+		}
+		return nil, s.check(params.TextDocument.URI)
+
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		doc, ok := s.documents[params.TextDocument.URI]
+		if !ok || len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		doc.text = params.ContentChanges[len(params.ContentChanges)-1].Text
+		s.scheduleCheck(params.TextDocument.URI)
+		return nil, nil
+
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		if doc, ok := s.documents[params.TextDocument.URI]; ok && doc.pendingCheck != nil {
+			doc.pendingCheck.Stop()
+		}
+		delete(s.documents, params.TextDocument.URI)
+		if err := s.publishDiagnostics(params.TextDocument.URI, []Diagnostic{}); err != nil {
+			return nil, &responseError{Code: codeInternalError, Message: err.Error()}
+		}
+		return nil, nil
+
+	case "textDocument/hover":
+		return s.withPosition(msg, hover)
+
+	case "textDocument/definition":
+		return s.withPosition(msg, definition)
+
+	case "textDocument/completion":
+		return s.withPosition(msg, completion)
+
+	case "textDocument/signatureHelp":
+		return s.withPosition(msg, signatureHelp)
+	}
+
+	if msg.ID == nil {
+		return nil, nil
+	}
+
+	return nil, &responseError{
+		Code:    codeMethodNotFound,
+		Message: fmt.Sprintf("method %s is not supported", msg.Method),
+	}
+}
+
+// withPosition decodes the position params and runs the feature on the checker of the document.
+func (s *session) withPosition(
+	msg message,
+	feature func(doc *document, uri string, position Position) interface{},
+) (interface{}, *responseError) {
+	var params TextDocumentPositionParams
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return nil, invalidParams(err)
+	}
+
+	// the features work on the latest text, even if it changed within the check delay
+	if rpcErr := s.flushCheck(params.TextDocument.URI); rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	doc, ok := s.documents[params.TextDocument.URI]
+	if !ok || doc.checker == nil || doc.checker.PositionInfo == nil {
+		return nil, nil
+	}
+
+	return feature(doc, params.TextDocument.URI, params.Position), nil
+}
+
+// scheduleCheck checks the document once it remains unchanged for the check delay.
+func (s *session) scheduleCheck(uri string) {
+	doc := s.documents[uri]
+	if doc.pendingCheck != nil {
+		doc.pendingCheck.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(checkDelay, func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		// the document was closed, changed again or already checked in the meantime
+		if s.documents[uri] != doc || doc.pendingCheck != timer {
+			return
+		}
+		doc.pendingCheck = nil
+
+		// the errors of the notifications aren't reported to the client
+		_ = s.check(uri)
+	})
+	doc.pendingCheck = timer
+}
+
+// flushCheck checks the document right away if it has a scheduled check.
+func (s *session) flushCheck(uri string) *responseError {
+	doc, ok := s.documents[uri]
+	if !ok || doc.pendingCheck == nil {
+		return nil
+	}
+
+	doc.pendingCheck.Stop()
+	doc.pendingCheck = nil
+	return s.check(uri)
+}
+
+// check type-checks the document against the project contract files and the deployed contracts,
+// and publishes the found errors as the document diagnostics.
+func (s *session) check(uri string) *responseError {
+	doc := s.documents[uri]
+
+	program, err := parser.ParseProgram(nil, []byte(doc.text), parser.Config{})
+	if err == nil {
+		doc.kind = codeKind(program)
+	}
+
+	contracts, err := s.contracts()
+	if err != nil {
+		return &responseError{Code: codeInternalError, Message: err.Error()}
+	}
+
+	checker, programErrors, err := s.handler.projects.CheckProgram(s.projectID, doc.text, doc.kind, contracts)
+	if err != nil {
+		return &responseError{Code: codeInternalError, Message: err.Error()}
+	}
+
+	// keep the previous checker while the code can't be parsed, so the completion still works while typing
+	if checker != nil {
+		doc.checker = checker
+		doc.checkedText = doc.text
+	}
+
+	diagnostics := make([]Diagnostic, len(programErrors))
+	for i, programError := range programErrors {
+		diagnostics[i] = diagnosticFromProgramError(doc.text, programError)
+	}
+
+	err = s.publishDiagnostics(uri, diagnostics)
+	if err != nil {
+		return &responseError{Code: codeInternalError, Message: err.Error()}
+	}
+
+	return nil
+}
+
+// contracts returns the codes of the project contract files by the contract names,
+// so the string imports of the contracts not deployed yet can be resolved.
+func (s *session) contracts() (map[string]string, error) {
+	var files []*model.File
+	err := s.handler.store.GetFilesForProject(s.projectID, &files, model.ContractFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get project contracts")
+	}
+
+	contracts := make(map[string]string)
+	for _, file := range files {
+		program, err := parser.ParseProgram(nil, []byte(file.Script), parser.Config{})
+		if err != nil {
+			continue
+		}
+
+		if name := contractName(program); name != "" {
+			contracts[name] = file.Script
+		}
+	}
+
+	return contracts, nil
+}
+
+func (s *session) publishDiagnostics(uri string, diagnostics []Diagnostic) error {
+	return s.conn.WriteJSON(message{
+		JSONRPC: jsonrpcVersion,
+		Method:  "textDocument/publishDiagnostics",
+		Params: mustMarshal(PublishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics,
+		}),
+	})
+}
+
+func (s *session) replyError(id *json.RawMessage, code int, msg string) error {
+	return s.conn.WriteJSON(message{
+		JSONRPC: jsonrpcVersion,
+		ID:      id,
+		Error:   &responseError{Code: code, Message: msg},
+	})
+}
+
+func invalidParams(err error) *responseError {
+	return &responseError{Code: codeInvalidParams, Message: err.Error()}
+}
+
+func mustMarshal(value interface{}) json.RawMessage {
+	data, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -20,6 +20,7 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"github.com/onflow/cadence"
 	flowsdk "github.com/onflow/flow-go-sdk"
 )
 
@@ -28,6 +29,13 @@ type Account struct {
 	Address           Address
 	DeployedContracts []string
 	State             string
+	// Balance and AvailableBalance are UFix64 amounts of FLOW tokens
+	Balance          string
+	AvailableBalance string
+	// Simulated is set for the accounts resulting from a simulated transaction, their state isn't kept in the project
+	Simulated bool
+	// StorageSizes are the bytes used by the values of the simulated account paths, keyed by the path
+	StorageSizes map[string]int
 }
 
 func AccountFromFlow(account *flowsdk.Account, projectID uuid.UUID) *Account {
@@ -40,6 +48,7 @@ func AccountFromFlow(account *flowsdk.Account, projectID uuid.UUID) *Account {
 		ProjectID:         projectID,
 		Address:           NewAddressFromBytes(account.Address.Bytes()),
 		DeployedContracts: contractNames,
+		Balance:           cadence.UFix64(account.Balance).String(),
 	}
 }
 
@@ -49,5 +58,9 @@ func (a *Account) Export() *Account {
 		Address:           a.Address,
 		DeployedContracts: a.DeployedContracts,
 		State:             a.State,
+		Balance:           a.Balance,
+		AvailableBalance:  a.AvailableBalance,
+		Simulated:         a.Simulated,
+		StorageSizes:      a.StorageSizes,
 	}
 }
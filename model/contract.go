@@ -19,6 +19,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	flowsdk "github.com/onflow/flow-go-sdk"
 	"github.com/pkg/errors"
@@ -28,12 +30,19 @@ type ContractTemplate = File
 
 type ContractDeployment struct {
 	File
-	Address     Address        `gorm:"serializer:json"`
-	Arguments   []string       `gorm:"serializer:json"`
-	BlockHeight int            `json:"blockHeight"`
-	Errors      []ProgramError `gorm:"serializer:json"`
-	Events      []Event        `gorm:"serializer:json"`
-	Logs        []string       `gorm:"serializer:json"`
+	TransactionInfo `gorm:"embedded"`
+
+	Address     Address  `gorm:"serializer:json"`
+	Arguments   []string `gorm:"serializer:json"`
+	BlockHeight int      `json:"blockHeight"`
+	// BlockTimestamp is the timestamp of the block, so the block is replayed at the same time
+	BlockTimestamp time.Time
+	Errors         []ProgramError `gorm:"serializer:json"`
+	Events         []Event        `gorm:"serializer:json"`
+	Logs           []string       `gorm:"serializer:json"`
+	LogEntries     []LogEntry     `gorm:"serializer:json"`
+	// IsUpdate is set when the deployment updated an existing contract in place
+	IsUpdate bool
 }
 
 func ContractDeploymentFromFlow(
@@ -43,8 +52,9 @@ func ContractDeploymentFromFlow(
 	arguments []string,
 	result *flowsdk.TransactionResult,
 	tx *flowsdk.Transaction,
-	logs []string,
+	logs []LogEntry,
 	blockHeight int,
+	usage TransactionUsage,
 ) *ContractDeployment {
 	signers := make([]Address, 0)
 	// transaction could be nil in case where we get transaction result errors
@@ -62,12 +72,14 @@ func ContractDeploymentFromFlow(
 			Type:      ContractFile,
 			Script:    script,
 		},
-		Arguments:   arguments,
-		Address:     signers[0],
-		BlockHeight: blockHeight,
-		Errors:      nil,
-		Events:      nil,
-		Logs:        logs,
+		TransactionInfo: TransactionInfoFromFlow(result, tx, usage),
+		Arguments:       arguments,
+		Address:         signers[0],
+		BlockHeight:     blockHeight,
+		Errors:          nil,
+		Events:          nil,
+		Logs:            logMessages(logs),
+		LogEntries:      logs,
 	}
 
 	if result.Events != nil {
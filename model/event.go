@@ -19,11 +19,104 @@
 package model
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/pkg/errors"
 )
 
+const (
+	DefaultEventsLimit = 100
+	MaxEventsLimit     = 1000
+)
+
+// ProjectEvent is an event emitted in the project history.
+//
+// Events are stored in their own table next to the executions and deployments that emitted them,
+// so they can be queried across the whole project history.
+type ProjectEvent struct {
+	ID            uuid.UUID `gorm:"primaryKey"`
+	ProjectID     uuid.UUID `gorm:"index:idx_project_events_height,priority:1;index:idx_project_events_type,priority:1"`
+	BlockHeight   int       `gorm:"index:idx_project_events_height,priority:2"`
+	TransactionID string
+	EventIndex    int
+	Type          string `gorm:"index:idx_project_events_type,priority:2"`
+	// ContractAddress is the hex address of the contract defining the event, empty for the core events
+	ContractAddress string   `gorm:"index"`
+	Values          []string `gorm:"serializer:json"`
+}
+
+// Address of the contract defining the event.
+func (e *ProjectEvent) Address() *Address {
+	if e.ContractAddress == "" {
+		return nil
+	}
+
+	address := NewAddressFromString(e.ContractAddress)
+	return &address
+}
+
+// ProjectEventsFromEvents creates project events from the events emitted in the transaction at the block height.
+func ProjectEventsFromEvents(
+	projectID uuid.UUID,
+	blockHeight int,
+	transactionID string,
+	events []Event,
+) []*ProjectEvent {
+	projectEvents := make([]*ProjectEvent, len(events))
+	for i, event := range events {
+		projectEvents[i] = &ProjectEvent{
+			ID:              uuid.New(),
+			ProjectID:       projectID,
+			BlockHeight:     blockHeight,
+			TransactionID:   transactionID,
+			EventIndex:      i,
+			Type:            event.Type,
+			ContractAddress: eventContractAddress(event.Type),
+			Values:          event.Values,
+		}
+	}
+
+	return projectEvents
+}
+
+// eventContractAddress parses the contract address from the event type in format A.{address}.{contract}.{event}.
+func eventContractAddress(eventType string) string {
+	parts := strings.Split(eventType, ".")
+	if len(parts) < 4 || parts[0] != "A" {
+		return ""
+	}
+
+	return parts[1]
+}
+
+// EventFilter limits the queried project events, only the set filters are applied.
+type EventFilter struct {
+	// TypePrefix matches the events with the type starting with the prefix.
+	TypePrefix string
+	Address    *Address
+	FromHeight *int
+	ToHeight   *int
+	Offset     int
+	Limit      int
+}
+
+func (f *EventFilter) Validate() error {
+	if f.Offset < 0 {
+		return fmt.Errorf("offset can't be negative")
+	}
+	if f.Limit < 1 || f.Limit > MaxEventsLimit {
+		return fmt.Errorf("limit must be between 1 and %d", MaxEventsLimit)
+	}
+	if f.FromHeight != nil && f.ToHeight != nil && *f.FromHeight > *f.ToHeight {
+		return fmt.Errorf("fromHeight can't be greater than toHeight")
+	}
+	return nil
+}
+
 func EventsFromFlow(flowEvents []flow.Event) ([]Event, error) {
 	events := make([]Event, len(flowEvents))
 
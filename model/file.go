@@ -38,4 +38,6 @@ type File struct {
 	Type      FileType  `json:"type"`
 	Index     int       `json:"index"`
 	Script    string    `json:"script"`
+	// FormatError is the reason the script was saved without formatting, it's only set in the update response
+	FormatError *string `json:"formatError" gorm:"-"`
 }
@@ -3,20 +3,93 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+
 	"github.com/Masterminds/semver"
 	"github.com/google/uuid"
 )
 
+type AccountStorage struct {
+	Items      []*StorageItem `json:"items"`
+	TotalCount int            `json:"totalCount"`
+}
+
+type AccountStorageDiff struct {
+	Address    Address          `json:"address"`
+	FromHeight int              `json:"fromHeight"`
+	ToHeight   int              `json:"toHeight"`
+	Changes    []*StorageChange `json:"changes"`
+}
+
+type BatchContractDeployment struct {
+	Script    string   `json:"script"`
+	Address   Address  `json:"address"`
+	Arguments []string `json:"arguments"`
+}
+
+type BatchScriptExecution struct {
+	Script    string   `json:"script"`
+	Arguments []string `json:"arguments"`
+}
+
+type BatchTransactionExecution struct {
+	Script    string    `json:"script"`
+	Signers   []Address `json:"signers"`
+	Arguments []string  `json:"arguments"`
+}
+
+type EmulatorConfigInput struct {
+	TransactionFeesEnabled       *bool `json:"transactionFeesEnabled"`
+	StorageLimitEnabled          *bool `json:"storageLimitEnabled"`
+	TransactionValidationEnabled *bool `json:"transactionValidationEnabled"`
+	ComputationLimit             *int  `json:"computationLimit"`
+}
+
 type Event struct {
 	Type   string   `json:"type"`
 	Values []string `json:"values"`
 }
 
+type EventList struct {
+	Events     []*ProjectEvent `json:"events"`
+	TotalCount int             `json:"totalCount"`
+}
+
+type ExecutionBatchResult struct {
+	ContractDeployment   *ContractDeployment   `json:"contractDeployment"`
+	TransactionExecution *TransactionExecution `json:"transactionExecution"`
+	ScriptExecution      *ScriptExecution      `json:"scriptExecution"`
+}
+
+type ExecutionBatchStep struct {
+	ContractDeployment   *BatchContractDeployment   `json:"contractDeployment"`
+	TransactionExecution *BatchTransactionExecution `json:"transactionExecution"`
+	ScriptExecution      *BatchScriptExecution      `json:"scriptExecution"`
+}
+
+type LogEntry struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+	// position of the log in the execution output, starting at 1
+	Line int `json:"line"`
+	// ID of the transaction which logged the message, not set for scripts
+	TransactionID *string `json:"transactionId"`
+}
+
+type NamedArgument struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 type NewContractDeployment struct {
-	ProjectID uuid.UUID `json:"projectId"`
-	Script    string    `json:"script"`
-	Address   Address   `json:"address"`
-	Arguments []string  `json:"arguments"`
+	ProjectID      uuid.UUID        `json:"projectId"`
+	Script         string           `json:"script"`
+	Address        Address          `json:"address"`
+	Arguments      []string         `json:"arguments"`
+	NamedArguments []*NamedArgument `json:"namedArguments"`
+	Update         *bool            `json:"update"`
 }
 
 type NewContractTemplate struct {
@@ -25,6 +98,11 @@ type NewContractTemplate struct {
 	Script    string    `json:"script"`
 }
 
+type NewExecutionBatch struct {
+	ProjectID uuid.UUID             `json:"projectId"`
+	Steps     []*ExecutionBatchStep `json:"steps"`
+}
+
 type NewFile struct {
 	ProjectID uuid.UUID `json:"projectId"`
 	Title     string    `json:"title"`
@@ -38,6 +116,7 @@ type NewProject struct {
 	Readme               string                           `json:"readme"`
 	Seed                 int                              `json:"seed"`
 	NumberOfAccounts     int                              `json:"numberOfAccounts"`
+	EmulatorConfig       *EmulatorConfigInput             `json:"emulatorConfig"`
 	TransactionTemplates []*NewProjectTransactionTemplate `json:"transactionTemplates"`
 	ScriptTemplates      []*NewProjectScriptTemplate      `json:"scriptTemplates"`
 	ContractTemplates    []*NewProjectContractTemplate    `json:"contractTemplates"`
@@ -64,9 +143,11 @@ type NewProjectTransactionTemplate struct {
 }
 
 type NewScriptExecution struct {
-	ProjectID uuid.UUID `json:"projectId"`
-	Script    string    `json:"script"`
-	Arguments []string  `json:"arguments"`
+	ProjectID      uuid.UUID        `json:"projectId"`
+	Script         string           `json:"script"`
+	Arguments      []string         `json:"arguments"`
+	NamedArguments []*NamedArgument `json:"namedArguments"`
+	BlockHeight    *int             `json:"blockHeight"`
 }
 
 type NewScriptTemplate struct {
@@ -76,10 +157,14 @@ type NewScriptTemplate struct {
 }
 
 type NewTransactionExecution struct {
-	ProjectID uuid.UUID `json:"projectId"`
-	Script    string    `json:"script"`
-	Signers   []Address `json:"signers"`
-	Arguments []string  `json:"arguments"`
+	ProjectID          uuid.UUID        `json:"projectId"`
+	Script             string           `json:"script"`
+	Signers            []Address        `json:"signers"`
+	Payer              *Address         `json:"payer"`
+	Proposer           *Address         `json:"proposer"`
+	Arguments          []string         `json:"arguments"`
+	NamedArguments     []*NamedArgument `json:"namedArguments"`
+	IncludeStorageDiff *bool            `json:"includeStorageDiff"`
 }
 
 type NewTransactionTemplate struct {
@@ -100,22 +185,65 @@ type ProgramError struct {
 	EndPosition   *ProgramPosition `json:"endPosition"`
 }
 
+type ProgramParameter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
 type ProgramPosition struct {
 	Offset int `json:"offset"`
 	Line   int `json:"line"`
 	Column int `json:"column"`
 }
 
+type ProgramSignature struct {
+	Kind       CodeKind            `json:"kind"`
+	Parameters []*ProgramParameter `json:"parameters"`
+	Signers    int                 `json:"signers"`
+	ReturnType *string             `json:"returnType"`
+}
+
 type ProjectList struct {
 	Projects []*Project `json:"projects"`
 }
 
+type StorageChange struct {
+	Path   string            `json:"path"`
+	Domain StorageDomain     `json:"domain"`
+	Kind   StorageChangeKind `json:"kind"`
+	Before *StorageItem      `json:"before"`
+	After  *StorageItem      `json:"after"`
+}
+
+type StorageField struct {
+	Name  string        `json:"name"`
+	Value *StorageValue `json:"value"`
+}
+
+type StorageItem struct {
+	Path   string        `json:"path"`
+	Domain StorageDomain `json:"domain"`
+	Type   string        `json:"type"`
+	Value  *StorageValue `json:"value"`
+	Target *string       `json:"target"`
+	// bytes used by the value in the account storage, the size of its registers or its encoded size if it is stored inline
+	Size int `json:"size"`
+}
+
+type StorageValue struct {
+	Type      string          `json:"type"`
+	Value     *string         `json:"value"`
+	Fields    []*StorageField `json:"fields"`
+	Truncated bool            `json:"truncated"`
+}
+
 type UpdateContractTemplate struct {
 	ID        uuid.UUID `json:"id"`
 	Title     *string   `json:"title"`
 	ProjectID uuid.UUID `json:"projectId"`
 	Index     *int      `json:"index"`
 	Script    *string   `json:"script"`
+	Format    *bool     `json:"format"`
 }
 
 type UpdateFile struct {
@@ -124,14 +252,16 @@ type UpdateFile struct {
 	ProjectID uuid.UUID `json:"projectId"`
 	Index     *int      `json:"index"`
 	Script    *string   `json:"script"`
+	Format    *bool     `json:"format"`
 }
 
 type UpdateProject struct {
-	ID          uuid.UUID `json:"id"`
-	Title       *string   `json:"title"`
-	Description *string   `json:"description"`
-	Readme      *string   `json:"readme"`
-	Persist     *bool     `json:"persist"`
+	ID             uuid.UUID            `json:"id"`
+	Title          *string              `json:"title"`
+	Description    *string              `json:"description"`
+	Readme         *string              `json:"readme"`
+	Persist        *bool                `json:"persist"`
+	EmulatorConfig *EmulatorConfigInput `json:"emulatorConfig"`
 }
 
 type UpdateScriptTemplate struct {
@@ -140,6 +270,7 @@ type UpdateScriptTemplate struct {
 	ProjectID uuid.UUID `json:"projectId"`
 	Index     *int      `json:"index"`
 	Script    *string   `json:"script"`
+	Format    *bool     `json:"format"`
 }
 
 type UpdateTransactionTemplate struct {
@@ -148,4 +279,134 @@ type UpdateTransactionTemplate struct {
 	ProjectID uuid.UUID `json:"projectId"`
 	Index     *int      `json:"index"`
 	Script    *string   `json:"script"`
+	Format    *bool     `json:"format"`
+}
+
+type CodeKind string
+
+const (
+	CodeKindContract    CodeKind = "CONTRACT"
+	CodeKindTransaction CodeKind = "TRANSACTION"
+	CodeKindScript      CodeKind = "SCRIPT"
+)
+
+var AllCodeKind = []CodeKind{
+	CodeKindContract,
+	CodeKindTransaction,
+	CodeKindScript,
+}
+
+func (e CodeKind) IsValid() bool {
+	switch e {
+	case CodeKindContract, CodeKindTransaction, CodeKindScript:
+		return true
+	}
+	return false
+}
+
+func (e CodeKind) String() string {
+	return string(e)
+}
+
+func (e *CodeKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CodeKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CodeKind", str)
+	}
+	return nil
+}
+
+func (e CodeKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type StorageChangeKind string
+
+const (
+	StorageChangeKindAdded    StorageChangeKind = "ADDED"
+	StorageChangeKindRemoved  StorageChangeKind = "REMOVED"
+	StorageChangeKindModified StorageChangeKind = "MODIFIED"
+)
+
+var AllStorageChangeKind = []StorageChangeKind{
+	StorageChangeKindAdded,
+	StorageChangeKindRemoved,
+	StorageChangeKindModified,
+}
+
+func (e StorageChangeKind) IsValid() bool {
+	switch e {
+	case StorageChangeKindAdded, StorageChangeKindRemoved, StorageChangeKindModified:
+		return true
+	}
+	return false
+}
+
+func (e StorageChangeKind) String() string {
+	return string(e)
+}
+
+func (e *StorageChangeKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StorageChangeKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StorageChangeKind", str)
+	}
+	return nil
+}
+
+func (e StorageChangeKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type StorageDomain string
+
+const (
+	StorageDomainStorage StorageDomain = "STORAGE"
+	StorageDomainPublic  StorageDomain = "PUBLIC"
+	StorageDomainPrivate StorageDomain = "PRIVATE"
+)
+
+var AllStorageDomain = []StorageDomain{
+	StorageDomainStorage,
+	StorageDomainPublic,
+	StorageDomainPrivate,
+}
+
+func (e StorageDomain) IsValid() bool {
+	switch e {
+	case StorageDomainStorage, StorageDomainPublic, StorageDomainPrivate:
+		return true
+	}
+	return false
+}
+
+func (e StorageDomain) String() string {
+	return string(e)
+}
+
+func (e *StorageDomain) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StorageDomain(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StorageDomain", str)
+	}
+	return nil
+}
+
+func (e StorageDomain) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
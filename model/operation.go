@@ -0,0 +1,53 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperationType is a kind of project state change other than a transaction execution or a contract deployment.
+type OperationType string
+
+const (
+	OperationCreateAccount     OperationType = "CREATE_ACCOUNT"
+	OperationRemoveContract    OperationType = "REMOVE_CONTRACT"
+	OperationCommitBlock       OperationType = "COMMIT_BLOCK"
+	OperationSetBlockTimestamp OperationType = "SET_BLOCK_TIMESTAMP"
+	OperationFundAccount       OperationType = "FUND_ACCOUNT"
+)
+
+// Operation is a project state change recorded in the project history at the block height,
+// so it can be replayed when the project state is recreated.
+type Operation struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	ProjectID   uuid.UUID `gorm:"index"`
+	BlockHeight int
+	Type        OperationType
+	Address     Address `gorm:"serializer:json"`
+	// ContractName is the name of the removed contract
+	ContractName string
+	// Timestamp is the timestamp of the block committed by the operation, so the block is replayed at the same time
+	Timestamp time.Time
+	// Amount is the UFix64 amount of FLOW tokens minted to the funded account
+	Amount    string
+	CreatedAt time.Time
+}
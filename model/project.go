@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver"
+	"github.com/dapperlabs/flow-playground-api/server/config"
 	"github.com/google/uuid"
 )
 
@@ -38,14 +39,75 @@ type Project struct {
 	Seed                      int
 	NumberOfAccounts          int
 	TransactionExecutionCount int
+	StateVersion              int64 // incremented every time the project state is rewound
 	Persist                   bool
 	CreatedAt                 time.Time
 	UpdatedAt                 time.Time
 	AccessedAt                time.Time
 	Version                   *semver.Version `gorm:"serializer:json"`
+	EmulatorConfig            EmulatorConfig  `gorm:"serializer:json"`
 	Mutable                   bool            // todo don't persist this
 }
 
+// DefaultComputationLimit is the computation limit for transactions and scripts of projects that don't set one.
+const DefaultComputationLimit = 100000
+
+// EmulatorConfig holds the emulator settings of the project.
+type EmulatorConfig struct {
+	TransactionFeesEnabled       bool `json:"transactionFeesEnabled"`
+	StorageLimitEnabled          bool `json:"storageLimitEnabled"`
+	TransactionValidationEnabled bool `json:"transactionValidationEnabled"`
+	ComputationLimit             int  `json:"computationLimit"`
+}
+
+// DefaultEmulatorConfig returns the emulator settings matching the behaviour of the playground before
+// the settings were configurable, fees, storage limit and transaction validation are all disabled.
+func DefaultEmulatorConfig() EmulatorConfig {
+	return EmulatorConfig{
+		ComputationLimit: DefaultComputationLimit,
+	}
+}
+
+// WithDefaults returns the config with the unset values replaced by defaults.
+func (c EmulatorConfig) WithDefaults() EmulatorConfig {
+	if c.ComputationLimit == 0 {
+		c.ComputationLimit = DefaultComputationLimit
+	}
+	return c
+}
+
+// Apply returns the config with the values provided in the input.
+func (c EmulatorConfig) Apply(input *EmulatorConfigInput) EmulatorConfig {
+	if input == nil {
+		return c
+	}
+	if input.TransactionFeesEnabled != nil {
+		c.TransactionFeesEnabled = *input.TransactionFeesEnabled
+	}
+	if input.StorageLimitEnabled != nil {
+		c.StorageLimitEnabled = *input.StorageLimitEnabled
+	}
+	if input.TransactionValidationEnabled != nil {
+		c.TransactionValidationEnabled = *input.TransactionValidationEnabled
+	}
+	if input.ComputationLimit != nil {
+		c.ComputationLimit = *input.ComputationLimit
+	}
+	return c
+}
+
+func (i *EmulatorConfigInput) Validate() error {
+	if i.ComputationLimit != nil && *i.ComputationLimit <= 0 {
+		return errors.New("computation limit must be a positive number")
+	}
+	// the executions hold the project lock, so the limit also bounds how long they can block the project
+	maxLimit := config.Playground().MaxComputationLimit
+	if i.ComputationLimit != nil && *i.ComputationLimit > maxLimit {
+		return errors.Errorf("computation limit can't be greater than %d", maxLimit)
+	}
+	return nil
+}
+
 func (p *Project) IsOwnedBy(userID uuid.UUID) bool {
 	return p.UserID == userID
 }
@@ -64,6 +126,7 @@ func (p *Project) ExportPublicMutable() *Project {
 		Seed:             p.Seed,
 		NumberOfAccounts: p.NumberOfAccounts,
 		Version:          p.Version,
+		EmulatorConfig:   p.EmulatorConfig.WithDefaults(),
 		UpdatedAt:        p.UpdatedAt,
 		Mutable:          true,
 	}
@@ -83,14 +146,18 @@ func (p *Project) ExportPublicImmutable() *Project {
 		Seed:             p.Seed,
 		NumberOfAccounts: p.NumberOfAccounts,
 		Version:          p.Version,
+		EmulatorConfig:   p.EmulatorConfig.WithDefaults(),
 		UpdatedAt:        p.UpdatedAt,
 		Mutable:          false,
 	}
 }
 
 func (u *UpdateProject) Validate() error {
-	if u.Title == nil && u.Readme == nil && u.Persist == nil && u.Description == nil {
-		return errors.Wrap(missingValuesError, "title, readme, persist, description")
+	if u.Title == nil && u.Readme == nil && u.Persist == nil && u.Description == nil && u.EmulatorConfig == nil {
+		return errors.Wrap(missingValuesError, "title, readme, persist, description, emulatorConfig")
+	}
+	if u.EmulatorConfig != nil {
+		return u.EmulatorConfig.Validate()
 	}
 	return nil
 }
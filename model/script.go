@@ -28,15 +28,18 @@ type ScriptTemplate = File
 
 type ScriptExecution struct {
 	File
-	Arguments []string `gorm:"serializer:json"`
-	Value     string
-	Errors    []ProgramError `gorm:"serializer:json"`
-	Logs      []string       `gorm:"serializer:json"`
+	Arguments  []string `gorm:"serializer:json"`
+	Value      string
+	Errors     []ProgramError `gorm:"serializer:json"`
+	Logs       []string       `gorm:"serializer:json"`
+	LogEntries []LogEntry     `gorm:"serializer:json"`
+	// BlockHeight is the height the script was executed at, nil for the latest block
+	BlockHeight *int
 }
 
 func ScriptExecutionFromFlow(
 	result cadence.Value,
-	logs []string,
+	logs []LogEntry,
 	projectID uuid.UUID,
 	script string,
 	arguments []string,
@@ -48,10 +51,11 @@ func ScriptExecutionFromFlow(
 			Type:      ScriptFile,
 			Script:    script,
 		},
-		Arguments: arguments,
-		Value:     result.String(),
-		Errors:    nil,
-		Logs:      logs,
+		Arguments:  arguments,
+		Value:      result.String(),
+		Errors:     nil,
+		Logs:       logMessages(logs),
+		LogEntries: logs,
 	}
 
 	/*
@@ -0,0 +1,35 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Snapshot is a serialized emulator state of a project at the block height.
+//
+// Snapshots are used to restore the project emulator without replaying all the executions.
+type Snapshot struct {
+	ProjectID   uuid.UUID `gorm:"primaryKey"`
+	BlockHeight int       `gorm:"primaryKey;autoIncrement:false"`
+	Data        []byte
+	CreatedAt   time.Time
+}
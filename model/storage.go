@@ -0,0 +1,450 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultStorageLimit = 100
+	MaxStorageLimit     = 1000
+	DefaultStorageDepth = 3
+	MaxStorageDepth     = 10
+)
+
+// maxStorageFields is the maximum number of fields, elements or entries decoded for a single value.
+const maxStorageFields = 100
+
+// StorageFilter limits the account storage items and how deep their values are decoded.
+type StorageFilter struct {
+	Offset int
+	Limit  int
+	Depth  int
+}
+
+func (f *StorageFilter) Validate() error {
+	if f.Offset < 0 {
+		return fmt.Errorf("offset can't be negative")
+	}
+	if f.Limit < 1 || f.Limit > MaxStorageLimit {
+		return fmt.Errorf("limit must be between 1 and %d", MaxStorageLimit)
+	}
+	if f.Depth < 0 || f.Depth > MaxStorageDepth {
+		return fmt.Errorf("depth must be between 0 and %d", MaxStorageDepth)
+	}
+	return nil
+}
+
+// storageEntry is a single path of the account state produced by the storage iteration script.
+type storageEntry struct {
+	path      cadence.Path
+	valueType string
+	value     cadence.Value
+}
+
+// StoragePage is the page of the account paths limited by the storage filter, the values are read
+// only for the paths of the page.
+type StoragePage struct {
+	entries    []storageEntry
+	TotalCount int
+}
+
+// StoragePageFromPaths decodes the JSON-Cadence account paths and selects the page of the paths sorted by the path.
+func StoragePageFromPaths(paths string, filter StorageFilter) (*StoragePage, error) {
+	entries, err := storageEntriesFromState(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].path.String() < entries[j].path.String()
+	})
+
+	page := &StoragePage{
+		entries:    make([]storageEntry, 0),
+		TotalCount: len(entries),
+	}
+	if filter.Offset < len(entries) {
+		end := filter.Offset + filter.Limit
+		if end > len(entries) {
+			end = len(entries)
+		}
+		page.entries = entries[filter.Offset:end]
+	}
+
+	return page, nil
+}
+
+// Paths returns the paths of the page.
+func (p *StoragePage) Paths() []cadence.Path {
+	paths := make([]cadence.Path, len(p.entries))
+	for i, entry := range p.entries {
+		paths[i] = entry.path
+	}
+	return paths
+}
+
+// AccountStorage decodes the JSON-Cadence values of the page paths into the storage items,
+// the sizes are the bytes used by the values in the account storage keyed by the path.
+func (p *StoragePage) AccountStorage(values string, sizes map[string]int, depth int) (*AccountStorage, error) {
+	entries, err := storageEntriesFromState(values)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesByPath := make(map[string]cadence.Value, len(entries))
+	for _, entry := range entries {
+		valuesByPath[entry.path.String()] = entry.value
+	}
+
+	for i, entry := range p.entries {
+		p.entries[i].value = valuesByPath[entry.path.String()]
+	}
+
+	return p.accountStorage(sizes, depth), nil
+}
+
+func (p *StoragePage) accountStorage(sizes map[string]int, depth int) *AccountStorage {
+	storage := &AccountStorage{
+		Items:      make([]*StorageItem, len(p.entries)),
+		TotalCount: p.TotalCount,
+	}
+	for i, entry := range p.entries {
+		storage.Items[i] = storageItemFromEntry(entry, sizes, depth)
+	}
+
+	return storage
+}
+
+// AccountStorageFromState decodes the JSON-Cadence account state, which already holds the values of all the paths,
+// into the storage items sorted by their paths.
+func AccountStorageFromState(state string, sizes map[string]int, filter StorageFilter) (*AccountStorage, error) {
+	page, err := StoragePageFromPaths(state, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.accountStorage(sizes, filter.Depth), nil
+}
+
+// StoragePathsFromState returns all the paths of the JSON-Cadence account state.
+func StoragePathsFromState(state string) ([]cadence.Path, error) {
+	entries, err := storageEntriesFromState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]cadence.Path, len(entries))
+	for i, entry := range entries {
+		paths[i] = entry.path
+	}
+	return paths, nil
+}
+
+func storageEntriesFromState(state string) ([]storageEntry, error) {
+	if state == "" {
+		return nil, nil
+	}
+
+	decoded, err := jsoncdc.Decode(nil, []byte(state))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode account state")
+	}
+
+	items, ok := decoded.(cadence.Array)
+	if !ok {
+		return nil, errors.New("account state must be an array")
+	}
+
+	entries := make([]storageEntry, len(items.Values))
+	for i, item := range items.Values {
+		dictionary, ok := item.(cadence.Dictionary)
+		if !ok {
+			return nil, errors.New("account state item must be a dictionary")
+		}
+
+		for _, pair := range dictionary.Pairs {
+			key, _ := pair.Key.(cadence.String)
+			switch key {
+			case "path":
+				entries[i].path, _ = pair.Value.(cadence.Path)
+			case "type":
+				valueType, _ := pair.Value.(cadence.String)
+				entries[i].valueType = string(valueType)
+			case "value":
+				entries[i].value = pair.Value
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+func storageItemFromEntry(entry storageEntry, sizes map[string]int, depth int) *StorageItem {
+	item := &StorageItem{
+		Path:   entry.path.String(),
+		Domain: storageDomainFromPath(entry.path),
+		Type:   entry.valueType,
+		Size:   sizes[entry.path.String()],
+	}
+
+	if entry.value == nil {
+		return item
+	}
+
+	item.Value = storageValueFromCadence(entry.value, depth)
+	// decoded arrays and dictionaries don't carry their static type
+	if item.Value.Type == "" {
+		item.Value.Type = entry.valueType
+	}
+
+	// public and private paths hold links, so their value is the target path
+	if item.Domain == StorageDomainStorage {
+		item.Target = capabilityTarget(entry.value)
+	} else if target, ok := unwrapOptional(entry.value).(cadence.Path); ok {
+		path := target.String()
+		item.Target = &path
+	}
+
+	return item
+}
+
+func storageDomainFromPath(path cadence.Path) StorageDomain {
+	switch path.Domain {
+	case common.PathDomainPublic:
+		return StorageDomainPublic
+	case common.PathDomainPrivate:
+		return StorageDomainPrivate
+	default:
+		return StorageDomainStorage
+	}
+}
+
+// capabilityTarget returns the target path of a stored path capability.
+func capabilityTarget(value cadence.Value) *string {
+	capability, ok := unwrapOptional(value).(cadence.PathCapability)
+	if !ok {
+		return nil
+	}
+
+	path := capability.Path.String()
+	return &path
+}
+
+func unwrapOptional(value cadence.Value) cadence.Value {
+	for {
+		optional, ok := value.(cadence.Optional)
+		if !ok {
+			return value
+		}
+		value = optional.Value
+	}
+}
+
+// storageValueFromCadence converts the value into a tree of storage values, the remaining depth limits how many
+// levels of nested values are decoded, deeper values are marked as truncated.
+func storageValueFromCadence(value cadence.Value, depth int) *StorageValue {
+	storageValue := &StorageValue{
+		Type: cadenceTypeID(value),
+	}
+
+	var fields []*StorageField
+	addField := func(name string, value cadence.Value) bool {
+		if len(fields) == maxStorageFields {
+			storageValue.Truncated = true
+			return false
+		}
+		fields = append(fields, &StorageField{
+			Name:  name,
+			Value: storageValueFromCadence(value, depth-1),
+		})
+		return true
+	}
+
+	switch value := value.(type) {
+	case cadence.Optional:
+		if value.Value == nil {
+			str := "nil"
+			storageValue.Value = &str
+			return storageValue
+		}
+
+		inner := storageValueFromCadence(value.Value, depth)
+		inner.Type = storageValue.Type
+		return inner
+	case cadence.Array:
+		if depth == 0 {
+			storageValue.Truncated = len(value.Values) > 0
+			return storageValue
+		}
+
+		fields = make([]*StorageField, 0)
+		for i, element := range value.Values {
+			if !addField(fmt.Sprintf("%d", i), element) {
+				break
+			}
+		}
+	case cadence.Dictionary:
+		if depth == 0 {
+			storageValue.Truncated = len(value.Pairs) > 0
+			return storageValue
+		}
+
+		fields = make([]*StorageField, 0)
+		for _, pair := range value.Pairs {
+			if !addField(pair.Key.String(), pair.Value) {
+				break
+			}
+		}
+	case cadence.HasFields:
+		if depth == 0 {
+			storageValue.Truncated = len(value.GetFieldValues()) > 0
+			return storageValue
+		}
+
+		fields = make([]*StorageField, 0)
+		compositeFields := value.GetFields()
+		for i, field := range value.GetFieldValues() {
+			if i >= len(compositeFields) {
+				break
+			}
+			if !addField(compositeFields[i].Identifier, field) {
+				break
+			}
+		}
+	default:
+		str := value.String()
+		storageValue.Value = &str
+	}
+
+	storageValue.Fields = fields
+	return storageValue
+}
+
+func cadenceTypeID(value cadence.Value) string {
+	valueType := value.Type()
+	if valueType == nil {
+		return ""
+	}
+
+	return valueType.ID()
+}
+
+// AccountStorageDiffFromStates compares the JSON-Cadence account states at two block heights
+// and returns the added, removed and modified paths sorted by the path.
+func AccountStorageDiffFromStates(
+	address Address,
+	fromHeight int,
+	toHeight int,
+	fromState string,
+	toState string,
+	fromSizes map[string]int,
+	toSizes map[string]int,
+) (*AccountStorageDiff, error) {
+	fromEntries, err := storageEntriesFromState(fromState)
+	if err != nil {
+		return nil, err
+	}
+
+	toEntries, err := storageEntriesFromState(toState)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[string]storageEntry, len(fromEntries))
+	for _, entry := range fromEntries {
+		before[entry.path.String()] = entry
+	}
+
+	changes := make([]*StorageChange, 0)
+	for _, entry := range toEntries {
+		path := entry.path.String()
+		previous, exists := before[path]
+		delete(before, path)
+
+		if !exists {
+			changes = append(changes, &StorageChange{
+				Path:   path,
+				Domain: storageDomainFromPath(entry.path),
+				Kind:   StorageChangeKindAdded,
+				After:  storageItemFromEntry(entry, toSizes, DefaultStorageDepth),
+			})
+			continue
+		}
+
+		if storageEntriesEqual(previous, entry) {
+			continue
+		}
+
+		changes = append(changes, &StorageChange{
+			Path:   path,
+			Domain: storageDomainFromPath(entry.path),
+			Kind:   StorageChangeKindModified,
+			Before: storageItemFromEntry(previous, fromSizes, DefaultStorageDepth),
+			After:  storageItemFromEntry(entry, toSizes, DefaultStorageDepth),
+		})
+	}
+
+	for path, entry := range before {
+		changes = append(changes, &StorageChange{
+			Path:   path,
+			Domain: storageDomainFromPath(entry.path),
+			Kind:   StorageChangeKindRemoved,
+			Before: storageItemFromEntry(entry, fromSizes, DefaultStorageDepth),
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return &AccountStorageDiff{
+		Address:    address,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		Changes:    changes,
+	}, nil
+}
+
+// storageEntriesEqual compares the types and the encoded values of the entries.
+func storageEntriesEqual(a, b storageEntry) bool {
+	if a.valueType != b.valueType {
+		return false
+	}
+
+	if a.value == nil || b.value == nil {
+		return a.value == b.value
+	}
+
+	encodedA, errA := jsoncdc.Encode(a.value)
+	encodedB, errB := jsoncdc.Encode(b.value)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	return bytes.Equal(encodedA, encodedB)
+}
@@ -19,6 +19,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	flowsdk "github.com/onflow/flow-go-sdk"
 	"github.com/pkg/errors"
@@ -28,23 +30,34 @@ type TransactionTemplate = File
 
 type TransactionExecution struct {
 	File
-	BlockHeight int            `json:"blockHeight"`
-	Arguments   []string       `gorm:"serializer:json"`
-	Signers     []Address      `gorm:"serializer:json"`
-	Errors      []ProgramError `gorm:"serializer:json"`
-	Events      []Event        `gorm:"serializer:json"`
-	Logs        []string       `gorm:"serializer:json"`
+	TransactionInfo `gorm:"embedded"`
+
+	BlockHeight int `json:"blockHeight"`
+	// BlockTimestamp is the timestamp of the block, so the block is replayed at the same time
+	BlockTimestamp time.Time
+	Arguments      []string       `gorm:"serializer:json"`
+	Signers        []Address      `gorm:"serializer:json"`
+	Payer          *Address       `gorm:"serializer:json"`
+	Proposer       *Address       `gorm:"serializer:json"`
+	Errors         []ProgramError `gorm:"serializer:json"`
+	Events         []Event        `gorm:"serializer:json"`
+	Logs           []string       `gorm:"serializer:json"`
+	LogEntries     []LogEntry     `gorm:"serializer:json"`
+	// StorageDiffs are the storage changes of the authorizers, only included in the execution response if requested
+	StorageDiffs []*AccountStorageDiff `gorm:"-"`
 }
 
 func TransactionExecutionFromFlow(
 	projectID uuid.UUID,
 	result *flowsdk.TransactionResult,
 	tx *flowsdk.Transaction,
-	logs []string,
+	logs []LogEntry,
 	blockHeight int,
+	usage TransactionUsage,
 ) *TransactionExecution {
 	args := make([]string, 0)
 	signers := make([]Address, 0)
+	var payer, proposer *Address
 	script := ""
 	// transaction could be nil in case where we get transaction result errors
 	if tx != nil {
@@ -56,6 +69,11 @@ func TransactionExecutionFromFlow(
 			signers = append(signers, NewAddressFromBytes(a.Bytes()))
 		}
 
+		payerAddress := NewAddressFromBytes(tx.Payer.Bytes())
+		payer = &payerAddress
+		proposerAddress := NewAddressFromBytes(tx.ProposalKey.Address.Bytes())
+		proposer = &proposerAddress
+
 		script = string(tx.Script)
 	}
 
@@ -66,12 +84,16 @@ func TransactionExecutionFromFlow(
 			Type:      TransactionFile,
 			Script:    script,
 		},
-		BlockHeight: blockHeight,
-		Arguments:   args,
-		Signers:     signers,
-		Errors:      nil,
-		Events:      nil,
-		Logs:        logs,
+		TransactionInfo: TransactionInfoFromFlow(result, tx, usage),
+		BlockHeight:     blockHeight,
+		Arguments:       args,
+		Signers:         signers,
+		Payer:           payer,
+		Proposer:        proposer,
+		Errors:          nil,
+		Events:          nil,
+		Logs:            logMessages(logs),
+		LogEntries:      logs,
 	}
 
 	if result.Events != nil {
@@ -86,6 +108,47 @@ func TransactionExecutionFromFlow(
 	return exe
 }
 
+// TransactionSimulation is the result of a transaction executed without committing it to the project state.
+type TransactionSimulation struct {
+	Script     string
+	Arguments  []string
+	Signers    []Address
+	Errors     []ProgramError
+	Events     []Event
+	Logs       []string
+	LogEntries []LogEntry
+	Accounts   []*Account
+}
+
+func TransactionSimulationFromFlow(
+	result *flowsdk.TransactionResult,
+	tx *flowsdk.Transaction,
+	logs []LogEntry,
+	accounts []*Account,
+) *TransactionSimulation {
+	exe := TransactionExecutionFromFlow(uuid.Nil, result, tx, logs, 0, TransactionUsage{})
+
+	return &TransactionSimulation{
+		Script:     exe.Script,
+		Arguments:  exe.Arguments,
+		Signers:    exe.Signers,
+		Errors:     exe.Errors,
+		Events:     exe.Events,
+		Logs:       exe.Logs,
+		LogEntries: exe.LogEntries,
+		Accounts:   accounts,
+	}
+}
+
+// logMessages returns the messages of the log entries in order.
+func logMessages(logs []LogEntry) []string {
+	messages := make([]string, len(logs))
+	for i, entry := range logs {
+		messages[i] = entry.Message
+	}
+	return messages
+}
+
 func (n *NewTransactionExecution) SignersToFlow() []flowsdk.Address {
 	return convertSigners(n.Signers)
 }
@@ -94,6 +157,32 @@ func (t *TransactionExecution) SignersToFlow() []flowsdk.Address {
 	return convertSigners(t.Signers)
 }
 
+func (n *NewTransactionExecution) PayerToFlow() *flowsdk.Address {
+	return convertRole(n.Payer)
+}
+
+func (n *NewTransactionExecution) ProposerToFlow() *flowsdk.Address {
+	return convertRole(n.Proposer)
+}
+
+func (t *TransactionExecution) PayerToFlow() *flowsdk.Address {
+	return convertRole(t.Payer)
+}
+
+func (t *TransactionExecution) ProposerToFlow() *flowsdk.Address {
+	return convertRole(t.Proposer)
+}
+
+// convertRole converts an optional transaction role account address, nil is returned if the role isn't provided.
+func convertRole(address *Address) *flowsdk.Address {
+	if address == nil {
+		return nil
+	}
+
+	flowAddress := address.ToFlowAddress()
+	return &flowAddress
+}
+
 func convertSigners(signers []Address) []flowsdk.Address {
 	sigs := make([]flowsdk.Address, len(signers))
 	for i, sig := range signers {
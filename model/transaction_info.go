@@ -0,0 +1,80 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"strings"
+
+	"github.com/onflow/cadence"
+	flowsdk "github.com/onflow/flow-go-sdk"
+)
+
+const feesDeductedEvent = "FlowFees.FeesDeducted"
+
+// TransactionUsage contains resources used by the transaction execution as reported by the emulator.
+type TransactionUsage struct {
+	ComputationUsed uint64
+	MemoryEstimate  uint64
+}
+
+// TransactionInfo identifies the executed transaction and describes its cost.
+type TransactionInfo struct {
+	TransactionID   string
+	Status          string
+	ComputationUsed int
+	MemoryEstimate  int
+	Fee             string
+}
+
+func TransactionInfoFromFlow(
+	result *flowsdk.TransactionResult,
+	tx *flowsdk.Transaction,
+	usage TransactionUsage,
+) TransactionInfo {
+	info := TransactionInfo{
+		Status:          result.Status.String(),
+		ComputationUsed: int(usage.ComputationUsed),
+		MemoryEstimate:  int(usage.MemoryEstimate),
+		Fee:             transactionFee(result.Events),
+	}
+
+	// transaction could be nil in case where we get transaction result errors
+	if tx != nil {
+		info.TransactionID = tx.ID().String()
+	}
+
+	return info
+}
+
+// transactionFee returns the fee amount deducted from the payer, fees are only deducted if enabled on the emulator.
+func transactionFee(events []flowsdk.Event) string {
+	for _, event := range events {
+		if !strings.HasSuffix(event.Type, feesDeductedEvent) {
+			continue
+		}
+
+		for i, field := range event.Value.EventType.Fields {
+			if field.Identifier == "amount" && i < len(event.Value.Fields) {
+				return event.Value.Fields[i].String()
+			}
+		}
+	}
+
+	return cadence.UFix64(0).String()
+}
@@ -20,6 +20,8 @@ package playground
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/Masterminds/semver"
@@ -65,6 +67,10 @@ func NewResolver(
 	}
 }
 
+func (r *Resolver) Account() AccountResolver {
+	return &accountResolver{r}
+}
+
 func (r *Resolver) Mutation() MutationResolver {
 	return &mutationResolver{r}
 }
@@ -154,6 +160,68 @@ func (r *mutationResolver) ResetProjectState(ctx context.Context, projectID uuid
 	return projectID, nil
 }
 
+func (r *mutationResolver) RollbackProject(ctx context.Context, projectID uuid.UUID, blockHeight int) (*model.Project, error) {
+	err := r.authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.projects.Rollback(projectID, blockHeight)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to rollback project")
+	}
+
+	proj, err := r.projects.Get(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return proj.ExportPublicMutable(), nil
+}
+
+func (r *mutationResolver) CommitBlocks(ctx context.Context, projectID uuid.UUID, count int) (*model.Block, error) {
+	err := r.authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.projects.CommitBlocks(projectID, count)
+}
+
+func (r *mutationResolver) SetBlockTimestamp(
+	ctx context.Context,
+	projectID uuid.UUID,
+	timestamp time.Time,
+) (*model.Block, error) {
+	err := r.authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.projects.SetBlockTimestamp(projectID, timestamp)
+}
+
+func (r *mutationResolver) AdvanceBlockTimestamp(
+	ctx context.Context,
+	projectID uuid.UUID,
+	seconds int,
+) (*model.Block, error) {
+	err := r.authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the duration would overflow for larger values
+	if seconds <= 0 || int64(seconds) >= math.MaxInt64/int64(time.Second) {
+		return nil, userErr.NewUserError(fmt.Sprintf(
+			"seconds must be between 1 and %d",
+			math.MaxInt64/int64(time.Second)-1,
+		))
+	}
+
+	return r.projects.AdvanceBlockTimestamp(projectID, time.Duration(seconds)*time.Second)
+}
+
 func (r *mutationResolver) DeleteProject(ctx context.Context, projectID uuid.UUID) (uuid.UUID, error) {
 	err := r.authorize(ctx, projectID)
 	if err != nil {
@@ -168,6 +236,29 @@ func (r *mutationResolver) DeleteProject(ctx context.Context, projectID uuid.UUI
 	return projectID, nil
 }
 
+func (r *mutationResolver) CreateAccount(ctx context.Context, projectID uuid.UUID) (*model.Account, error) {
+	err := r.authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.accounts.Create(projectID)
+}
+
+func (r *mutationResolver) FundAccount(
+	ctx context.Context,
+	projectID uuid.UUID,
+	address model.Address,
+	amount string,
+) (*model.Account, error) {
+	err := r.authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.accounts.Fund(projectID, address, amount)
+}
+
 func (r *mutationResolver) CreateTransactionTemplate(ctx context.Context, input model.NewTransactionTemplate) (*model.TransactionTemplate, error) {
 	err := r.authorize(ctx, input.ProjectID)
 	if err != nil {
@@ -220,6 +311,18 @@ func (r *mutationResolver) CreateTransactionExecution(
 	return exe, nil
 }
 
+func (r *mutationResolver) SimulateTransaction(
+	ctx context.Context,
+	input model.NewTransactionExecution,
+) (*model.TransactionSimulation, error) {
+	err := r.authorize(ctx, input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.files.SimulateTransaction(input)
+}
+
 func (r *mutationResolver) CreateScriptTemplate(ctx context.Context, input model.NewScriptTemplate) (*model.ScriptTemplate, error) {
 	err := r.authorize(ctx, input.ProjectID)
 	if err != nil {
@@ -278,6 +381,18 @@ func (r *mutationResolver) CreateScriptExecution(
 	return r.files.CreateScriptExecution(input)
 }
 
+func (r *mutationResolver) CreateExecutionBatch(
+	ctx context.Context,
+	input model.NewExecutionBatch,
+) ([]*model.ExecutionBatchResult, error) {
+	err := r.authorize(ctx, input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.files.CreateExecutionBatch(input)
+}
+
 func (r *mutationResolver) CreateContractTemplate(ctx context.Context, input model.NewContractTemplate) (*model.File, error) {
 	err := r.authorize(ctx, input.ProjectID)
 	if err != nil {
@@ -343,6 +458,20 @@ func (r *mutationResolver) CreateContractDeployment(
 	return deployment, nil
 }
 
+func (r *mutationResolver) RemoveContract(
+	ctx context.Context,
+	projectID uuid.UUID,
+	address model.Address,
+	name string,
+) (*model.Account, error) {
+	err := r.authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.files.RemoveContract(projectID, address, name)
+}
+
 type projectResolver struct{ *Resolver }
 
 func (r *projectResolver) TransactionTemplates(_ context.Context, proj *model.Project) ([]*model.TransactionTemplate, error) {
@@ -400,6 +529,32 @@ func (r *projectResolver) UpdatedAt(_ context.Context, proj *model.Project) (str
 	return proj.UpdatedAt.Format(time.RFC1123Z), nil
 }
 
+type accountResolver struct{ *Resolver }
+
+func (r *accountResolver) Storage(
+	_ context.Context,
+	account *model.Account,
+	offset *int,
+	limit *int,
+	depth *int,
+) (*model.AccountStorage, error) {
+	filter := model.StorageFilter{
+		Limit: model.DefaultStorageLimit,
+		Depth: model.DefaultStorageDepth,
+	}
+	if offset != nil {
+		filter.Offset = *offset
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if depth != nil {
+		filter.Depth = *depth
+	}
+
+	return r.accounts.Storage(account, filter)
+}
+
 type queryResolver struct{ *Resolver }
 
 func (r *queryResolver) PlaygroundInfo(_ context.Context) (*model.PlaygroundInfo, error) {
@@ -444,6 +599,16 @@ func (r *queryResolver) Account(_ context.Context, address model.Address, projec
 	return r.accounts.GetByAddress(address, projectID)
 }
 
+func (r *queryResolver) AccountStorageDiff(
+	_ context.Context,
+	projectID uuid.UUID,
+	address model.Address,
+	fromHeight int,
+	toHeight int,
+) (*model.AccountStorageDiff, error) {
+	return r.accounts.StorageDiff(projectID, address, fromHeight, toHeight)
+}
+
 func (r *queryResolver) ProjectList(ctx context.Context) (*model.ProjectList, error) {
 	user, err := r.auth.GetOrCreateUser(ctx)
 	if err != nil {
@@ -453,6 +618,52 @@ func (r *queryResolver) ProjectList(ctx context.Context) (*model.ProjectList, er
 	return r.projects.GetProjectListForUser(user.ID)
 }
 
+func (r *queryResolver) Events(
+	_ context.Context,
+	projectID uuid.UUID,
+	eventType *string,
+	address *model.Address,
+	fromHeight *int,
+	toHeight *int,
+	offset *int,
+	limit *int,
+) (*model.EventList, error) {
+	filter := model.EventFilter{
+		Address:    address,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		Limit:      model.DefaultEventsLimit,
+	}
+	if eventType != nil {
+		filter.TypePrefix = *eventType
+	}
+	if offset != nil {
+		filter.Offset = *offset
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+
+	return r.projects.GetEvents(projectID, filter)
+}
+
 func (r *queryResolver) FlowJSON(_ context.Context, projectID uuid.UUID) (string, error) {
 	return r.files.GetFlowJson(projectID)
 }
+
+func (r *queryResolver) CheckCode(
+	_ context.Context,
+	projectID uuid.UUID,
+	script string,
+	kind model.CodeKind,
+) ([]*model.ProgramError, error) {
+	return r.files.CheckCode(projectID, script, kind)
+}
+
+func (r *queryResolver) ProgramSignature(_ context.Context, script string) (*model.ProgramSignature, error) {
+	return r.files.ProgramSignature(script)
+}
+
+func (r *queryResolver) FormatCode(_ context.Context, script string) (string, error) {
+	return r.files.FormatCode(script)
+}
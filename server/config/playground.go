@@ -30,9 +30,14 @@ type PlaygroundConfig struct {
 	SessionCookiesHTTPOnly     bool          `default:"true"`
 	SessionCookiesSameSiteNone bool          `default:"false"`
 	LedgerCacheSize            int           `default:"128"`
+	LedgerCacheMaxBytes        int64         `default:"4294967296"`
+	SnapshotInterval           int           `default:"10"`
+	StatePollInterval          time.Duration `default:"5s"`
 	PlaygroundBaseURL          string        `default:"http://localhost:3000"`
 	ForceMigration             bool          `default:"false"`
 	MaxProjectsLimit           int           `default:"50"`
+	MaxAccountsLimit           int           `default:"50"`
+	MaxComputationLimit        int           `default:"1000000"`
 	StaleProjectDays           int           `default:"90"`
 	StorageBackend             string
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/dapperlabs/flow-playground-api/blockchain"
 	"github.com/dapperlabs/flow-playground-api/build"
 	"github.com/dapperlabs/flow-playground-api/controller"
+	"github.com/dapperlabs/flow-playground-api/languageserver"
 	"github.com/dapperlabs/flow-playground-api/middleware/errors"
 	"github.com/dapperlabs/flow-playground-api/middleware/httpcontext"
 	"github.com/dapperlabs/flow-playground-api/middleware/monitoring"
@@ -107,6 +108,7 @@ func main() {
 	sessionAuthKey := []byte(conf.SessionAuthKey)
 	authenticator := auth.NewAuthenticator(store, sessionName)
 	chain := blockchain.NewProjects(store, initAccountsNumber)
+	go chain.WatchStateInvalidations(ctx, conf.StatePollInterval)
 	resolver := playground.NewResolver(build.Version(), store, authenticator, chain)
 
 	router := chi.NewRouter()
@@ -136,6 +138,16 @@ func main() {
 	logger.Formatter = stackdriver.NewFormatter(stackdriver.WithService("flow-playground"))
 	entry := logrus.NewEntry(logger)
 
+	cookieStore := gsessions.NewCookieStore(sessionAuthKey)
+	cookieStore.MaxAge(int(conf.SessionMaxAge.Seconds()))
+
+	cookieStore.Options.Secure = conf.SessionCookiesSecure
+	cookieStore.Options.HttpOnly = conf.SessionCookiesHTTPOnly
+
+	if conf.SessionCookiesSameSiteNone {
+		cookieStore.Options.SameSite = http.SameSiteNoneMode
+	}
+
 	router.Route("/query", func(r chi.Router) {
 		// Add CORS middleware around every request
 		// See https://github.com/rs/cors for full option listing
@@ -144,16 +156,6 @@ func main() {
 			AllowCredentials: true,
 		}).Handler)
 
-		cookieStore := gsessions.NewCookieStore(sessionAuthKey)
-		cookieStore.MaxAge(int(conf.SessionMaxAge.Seconds()))
-
-		cookieStore.Options.Secure = conf.SessionCookiesSecure
-		cookieStore.Options.HttpOnly = conf.SessionCookiesHTTPOnly
-
-		if conf.SessionCookiesSameSiteNone {
-			cookieStore.Options.SameSite = http.SameSiteNoneMode
-		}
-
 		// Create a new hub for this subroutine and bind current client and handle to scope
 		localHub := sentry.CurrentHub().Clone()
 		localHub.ConfigureScope(func(scope *sentry.Scope) {
@@ -184,6 +186,11 @@ func main() {
 	embedsHandler := controller.NewEmbedsHandler(store, conf.PlaygroundBaseURL)
 	router.Handle("/embed", embedsHandler)
 
+	languageServerHandler := languageserver.NewHandler(store, chain, authenticator, conf.AllowedOrigins)
+	router.
+		With(httpcontext.Middleware(), sessions.Middleware(cookieStore)).
+		Handle("/lsp", languageServerHandler)
+
 	err := ping.SetPingHandlers(store.Ping)
 	if err != nil {
 		log.Fatal(err)
@@ -19,17 +19,22 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"github.com/Masterminds/semver"
 	"github.com/dapperlabs/flow-playground-api/model"
 	"github.com/dapperlabs/flow-playground-api/server/config"
 	"github.com/getsentry/sentry-go"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -37,6 +42,9 @@ var _ Store = &SQL{}
 
 const PostgreSQL = "postgresql"
 
+// stateInvalidationChannel is the PostgreSQL notification channel of the project state rewinds.
+const stateInvalidationChannel = "project_state_invalidations"
+
 // NewInMemory database, warning not concurrency safe, do not use for e2e tests
 func NewInMemory() *SQL {
 	return newSQL(sqlite.Open(":memory:"), logger.Warn)
@@ -58,7 +66,9 @@ func NewPostgreSQL(conf *config.DatabaseConfig) *SQL {
 		),
 	}
 
-	return newSQL(postgres.New(cfg), logger.Error)
+	s := newSQL(postgres.New(cfg), logger.Error)
+	s.listenDSN = cfg.DSN
+	return s
 }
 
 func newSQL(dial gorm.Dialector, level logger.LogLevel) *SQL {
@@ -99,6 +109,9 @@ func newSQL(dial gorm.Dialector, level logger.LogLevel) *SQL {
 }
 
 func migrate(db *gorm.DB) {
+	// events of the existing history are only stored in the executions and deployments before the table is created
+	backfillEvents := !db.Migrator().HasTable(&model.ProjectEvent{})
+
 	err := db.AutoMigrate(
 		&model.Project{},
 		&model.File{},
@@ -106,17 +119,78 @@ func migrate(db *gorm.DB) {
 		&model.ScriptExecution{},
 		&model.TransactionExecution{},
 		&model.User{},
+		&model.Snapshot{},
+		&model.Operation{},
+		&model.ProjectEvent{},
 	)
 	if err != nil {
 		err := errors.Wrap(err, "failed to migrate database")
 		sentry.CaptureException(err)
 		fmt.Println(err.Error())
 		//panic(err)
+		return
+	}
+
+	if backfillEvents {
+		err = backfillProjectEvents(db)
+		if err != nil {
+			// the table is created again on the next start, so the backfill can be retried
+			_ = db.Migrator().DropTable(&model.ProjectEvent{})
+
+			err := errors.Wrap(err, "failed to backfill project events")
+			sentry.CaptureException(err)
+			fmt.Println(err.Error())
+		}
 	}
 }
 
+// backfillProjectEvents inserts the project events emitted by the transaction executions and contract deployments
+// recorded before the project events table existed.
+func backfillProjectEvents(db *gorm.DB) error {
+	const batchSize = 100
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var exes []*model.TransactionExecution
+		err := tx.FindInBatches(&exes, batchSize, func(_ *gorm.DB, _ int) error {
+			for _, exe := range exes {
+				err := insertProjectEvents(tx, model.ProjectEventsFromEvents(
+					exe.ProjectID,
+					exe.BlockHeight,
+					exe.TransactionID,
+					exe.Events,
+				))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		var deploys []*model.ContractDeployment
+		return tx.FindInBatches(&deploys, batchSize, func(_ *gorm.DB, _ int) error {
+			for _, deploy := range deploys {
+				err := insertProjectEvents(tx, model.ProjectEventsFromEvents(
+					deploy.ProjectID,
+					deploy.BlockHeight,
+					deploy.TransactionID,
+					deploy.Events,
+				))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+	})
+}
+
 type SQL struct {
 	db *gorm.DB
+	// listenDSN is used to open the dedicated connection listening to notifications, only set for PostgreSQL.
+	listenDSN string
 }
 
 func (s *SQL) InsertUser(user *model.User) error {
@@ -166,9 +240,43 @@ func (s *SQL) UpdateProject(input model.UpdateProject, proj *model.Project) erro
 
 	update["accessed_at"] = time.Now()
 
-	err := s.db.
-		Model(&model.Project{ID: input.ID}).
-		Updates(update).Error
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&model.Project{ID: input.ID}).
+			Updates(update).Error
+		if err != nil {
+			return err
+		}
+
+		if input.EmulatorConfig == nil {
+			return nil
+		}
+
+		var current model.Project
+		err = tx.First(&current, input.ID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Model(&model.Project{ID: input.ID}).
+			Select("EmulatorConfig").
+			Updates(&model.Project{EmulatorConfig: current.EmulatorConfig.WithDefaults().Apply(input.EmulatorConfig)}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// snapshots were created with the previous emulator configuration
+		err = tx.Where("project_id=?", input.ID).
+			Delete(&model.Snapshot{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return s.rewindProjectState(tx, input.ID)
+	})
 	if err != nil {
 		return err
 	}
@@ -226,13 +334,34 @@ func (s *SQL) ResetProjectState(proj *model.Project) error {
 			return err
 		}
 
+		err = tx.Where(&model.Snapshot{ProjectID: proj.ID}).
+			Delete(&model.Snapshot{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where(&model.Operation{ProjectID: proj.ID}).
+			Delete(&model.Operation{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where(&model.ProjectEvent{ProjectID: proj.ID}).
+			Delete(&model.ProjectEvent{}).Error
+		if err != nil {
+			return err
+		}
+
 		err = tx.
 			Model(&model.Project{ID: proj.ID}).
 			Updates(map[string]any{ // need to use map due to zero value, see https://gorm.io/docs/update.html
 				"TransactionExecutionCount": 0,
 			}).Error
+		if err != nil {
+			return err
+		}
 
-		return err
+		return s.rewindProjectState(tx, proj.ID)
 	})
 }
 
@@ -263,6 +392,21 @@ func (s *SQL) DeleteProject(id uuid.UUID) error {
 			return err
 		}
 
+		if err := tx.Where(&model.Snapshot{ProjectID: id}).
+			Delete(&model.Snapshot{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where(&model.Operation{ProjectID: id}).
+			Delete(&model.Operation{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where(&model.ProjectEvent{ProjectID: id}).
+			Delete(&model.ProjectEvent{}).Error; err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
@@ -271,6 +415,113 @@ func (s *SQL) GetProject(id uuid.UUID, proj *model.Project) error {
 	return s.db.First(proj, id).Error
 }
 
+func (s *SQL) GetProjectStateVersions(ids []uuid.UUID, versions map[uuid.UUID]int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var projs []*model.Project
+	err := s.db.
+		Select("id", "state_version").
+		Where("id IN ?", ids).
+		Find(&projs).Error
+	if err != nil {
+		return err
+	}
+
+	for _, proj := range projs {
+		versions[proj.ID] = proj.StateVersion
+	}
+
+	return nil
+}
+
+// rewindProjectState increments the state version of the project within the transaction, so the emulators
+// built from the previous state are discarded, and notifies the listening replicas once the transaction commits.
+func (s *SQL) rewindProjectState(tx *gorm.DB, projectID uuid.UUID) error {
+	err := tx.
+		Model(&model.Project{ID: projectID}).
+		UpdateColumn("state_version", gorm.Expr("state_version + 1")).Error
+	if err != nil {
+		return err
+	}
+
+	if s.db.Dialector.Name() != "postgres" {
+		return nil
+	}
+
+	var version int64
+	err = tx.
+		Model(&model.Project{}).
+		Select("state_version").
+		Where("id = ?", projectID).
+		Scan(&version).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Exec(
+		"SELECT pg_notify(?, ?)",
+		stateInvalidationChannel,
+		fmt.Sprintf("%s:%d", projectID, version),
+	).Error
+}
+
+func (s *SQL) ListenStateInvalidations(
+	ctx context.Context,
+	handler func(projectID uuid.UUID, stateVersion int64),
+) error {
+	if s.listenDSN == "" {
+		return ErrNotSupported
+	}
+
+	conn, err := pgx.Connect(ctx, s.listenDSN)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect the notification listener")
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+stateInvalidationChannel)
+	if err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		projectID, version, err := parseStateInvalidation(notification.Payload)
+		if err != nil {
+			sentry.CaptureException(err)
+			continue
+		}
+
+		handler(projectID, version)
+	}
+}
+
+// parseStateInvalidation parses the notification payload in the format "<project ID>:<state version>".
+func parseStateInvalidation(payload string) (uuid.UUID, int64, error) {
+	id, version, found := strings.Cut(payload, ":")
+	if !found {
+		return uuid.Nil, 0, fmt.Errorf("invalid state invalidation payload: %s", payload)
+	}
+
+	projectID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, 0, errors.Wrap(err, "invalid state invalidation project ID")
+	}
+
+	stateVersion, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return uuid.Nil, 0, errors.Wrap(err, "invalid state invalidation version")
+	}
+
+	return projectID, stateVersion, nil
+}
+
 func (s *SQL) GetAllProjectsForUser(userID uuid.UUID, proj *[]*model.Project) error {
 	return s.db.Where(&model.Project{UserID: userID}).
 		Order("\"updated_at\" desc").
@@ -315,6 +566,21 @@ func (s *SQL) DeleteStaleProjects(stale time.Duration) error {
 				return err
 			}
 
+			if err := tx.Where(&model.Snapshot{ProjectID: proj.ID}).
+				Delete(&model.Snapshot{}).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Where(&model.Operation{ProjectID: proj.ID}).
+				Delete(&model.Operation{}).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Where(&model.ProjectEvent{ProjectID: proj.ID}).
+				Delete(&model.ProjectEvent{}).Error; err != nil {
+				return err
+			}
+
 			return nil
 		})
 
@@ -407,7 +673,18 @@ func (s *SQL) GetScriptExecutionsForProject(projectID uuid.UUID, exes *[]*model.
 }
 
 func (s *SQL) InsertContractDeployment(deploy *model.ContractDeployment) error {
-	return s.db.Create(deploy).Error
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(deploy).Error; err != nil {
+			return err
+		}
+
+		return insertProjectEvents(tx, model.ProjectEventsFromEvents(
+			deploy.ProjectID,
+			deploy.BlockHeight,
+			deploy.TransactionID,
+			deploy.Events,
+		))
+	})
 }
 
 func (s *SQL) DeleteContractDeployment(deploy *model.ContractDeployment) error {
@@ -448,28 +725,31 @@ func (s *SQL) GetContractDeploymentOnAddress(
 	deployment *model.ContractDeployment) error {
 	return s.db.Where(&model.ContractDeployment{
 		File: model.File{ProjectID: projectID, Title: title}, Address: address}).
+		Where("is_update = ?", false).
+		Order("block_height desc").
+		Limit(1).
 		Find(&deployment).
 		Error
 }
 
 func (s *SQL) InsertTransactionExecution(exe *model.TransactionExecution) error {
-	var proj model.Project
-	if err := s.db.First(&proj, &model.Project{ID: exe.ProjectID}).Error; err != nil {
-		return err
-	}
-
 	return s.db.Transaction(func(tx *gorm.DB) error {
-		exe.Index = proj.TransactionExecutionCount
-		proj.TransactionExecutionCount += 1
-		if err := tx.Save(proj).Error; err != nil {
+		index, err := incrementTransactionExecutionCount(tx, exe.ProjectID, 1)
+		if err != nil {
 			return err
 		}
 
+		exe.Index = index
 		if err := tx.Create(exe).Error; err != nil {
 			return err
 		}
 
-		return nil
+		return insertProjectEvents(tx, model.ProjectEventsFromEvents(
+			exe.ProjectID,
+			exe.BlockHeight,
+			exe.TransactionID,
+			exe.Events,
+		))
 	})
 }
 
@@ -504,10 +784,230 @@ func (s *SQL) TruncateDeploymentsAndExecutionsAtBlockHeight(projectID uuid.UUID,
 			return err
 		}
 
+		err = tx.Where("project_id=? AND block_height >= ?", projectID, blockHeight).
+			Delete(&model.Snapshot{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("project_id=? AND block_height >= ?", projectID, blockHeight).
+			Delete(&model.Operation{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("project_id=? AND block_height >= ?", projectID, blockHeight).
+			Delete(&model.ProjectEvent{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return s.rewindProjectState(tx, projectID)
+	})
+}
+
+// InsertExecutionBatch inserts all the batch results in a single database transaction.
+func (s *SQL) InsertExecutionBatch(
+	projectID uuid.UUID,
+	deployments []*model.ContractDeployment,
+	executions []*model.TransactionExecution,
+	scripts []*model.ScriptExecution,
+) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		index, err := incrementTransactionExecutionCount(tx, projectID, len(executions))
+		if err != nil {
+			return err
+		}
+
+		for i, exe := range executions {
+			exe.Index = index + i
+			if err := tx.Create(exe).Error; err != nil {
+				return err
+			}
+
+			err := insertProjectEvents(tx, model.ProjectEventsFromEvents(
+				projectID,
+				exe.BlockHeight,
+				exe.TransactionID,
+				exe.Events,
+			))
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, deploy := range deployments {
+			if err := tx.Create(deploy).Error; err != nil {
+				return err
+			}
+
+			err := insertProjectEvents(tx, model.ProjectEventsFromEvents(
+				projectID,
+				deploy.BlockHeight,
+				deploy.TransactionID,
+				deploy.Events,
+			))
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, exe := range scripts {
+			if err := tx.Create(exe).Error; err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 }
 
+// incrementTransactionExecutionCount increments the transaction execution count of the project
+// within the transaction and returns the index of the first inserted execution.
+// Only the count column is updated, so the concurrent changes of the project are not overwritten.
+func incrementTransactionExecutionCount(tx *gorm.DB, projectID uuid.UUID, count int) (int, error) {
+	// the update locks the project row until the transaction ends, so the count read after it is not stale
+	res := tx.
+		Model(&model.Project{ID: projectID}).
+		UpdateColumn("transaction_execution_count", gorm.Expr("transaction_execution_count + ?", count))
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	var total int
+	err := tx.
+		Model(&model.Project{}).
+		Select("transaction_execution_count").
+		Where("id = ?", projectID).
+		Scan(&total).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return total - count, nil
+}
+
+func (s *SQL) InsertOperation(operation *model.Operation) error {
+	return s.db.Create(operation).Error
+}
+
+func (s *SQL) GetOperationsForProject(projectID uuid.UUID, operations *[]*model.Operation) error {
+	return s.db.Where(&model.Operation{ProjectID: projectID}).
+		Order("\"block_height\" asc").
+		Find(operations).Error
+}
+
+func insertProjectEvents(tx *gorm.DB, events []*model.ProjectEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return tx.Create(events).Error
+}
+
+// GetEventsForProject gets the project events matching the filter ordered by the block height
+// and the total count of the matching events.
+func (s *SQL) GetEventsForProject(
+	projectID uuid.UUID,
+	filter model.EventFilter,
+	events *[]*model.ProjectEvent,
+	total *int64,
+) error {
+	query := s.db.Model(&model.ProjectEvent{}).
+		Where(&model.ProjectEvent{ProjectID: projectID})
+
+	if filter.TypePrefix != "" {
+		query = query.Where("type LIKE ? ESCAPE '\\'", likePrefix(filter.TypePrefix))
+	}
+	if filter.Address != nil {
+		query = query.Where("contract_address = ?", filter.Address.ToFlowAddress().Hex())
+	}
+	if filter.FromHeight != nil {
+		query = query.Where("block_height >= ?", *filter.FromHeight)
+	}
+	if filter.ToHeight != nil {
+		query = query.Where("block_height <= ?", *filter.ToHeight)
+	}
+
+	// share the conditions between counting and finding the events
+	query = query.Session(&gorm.Session{})
+
+	if err := query.Count(total).Error; err != nil {
+		return err
+	}
+
+	return query.
+		Order("\"block_height\" asc").
+		Order("\"event_index\" asc").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(events).
+		Error
+}
+
+// likePrefix escapes the LIKE wildcards in the prefix and matches anything after it.
+func likePrefix(prefix string) string {
+	replacer := strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_")
+	return replacer.Replace(prefix) + "%"
+}
+
+// snapshotsPerProject is the number of the latest project snapshots kept,
+// the earlier ones are still useful to restore the project after a rollback.
+const snapshotsPerProject = 3
+
+// InsertSnapshot inserts the snapshot and deletes the project snapshots older than the latest kept ones.
+func (s *SQL) InsertSnapshot(snapshot *model.Snapshot) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.OnConflict{UpdateAll: true}).
+			Create(snapshot).
+			Error
+		if err != nil {
+			return err
+		}
+
+		var kept []int
+		err = tx.
+			Model(&model.Snapshot{}).
+			Where(&model.Snapshot{ProjectID: snapshot.ProjectID}).
+			Order("\"block_height\" desc").
+			Limit(snapshotsPerProject).
+			Pluck("block_height", &kept).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Where(&model.Snapshot{ProjectID: snapshot.ProjectID}).
+			Where("block_height NOT IN ?", kept).
+			Delete(&model.Snapshot{}).
+			Error
+	})
+}
+
+func (s *SQL) GetLatestSnapshot(projectID uuid.UUID, snapshot *model.Snapshot) error {
+	result := s.db.Where(&model.Snapshot{ProjectID: projectID}).
+		Order("\"block_height\" desc").
+		Limit(1).
+		Find(snapshot)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 func (s *SQL) Ping() error {
 	db, err := s.db.DB()
 	if err != nil {
@@ -0,0 +1,177 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"sync"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/dapperlabs/flow-playground-api/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProject(t *testing.T, store *SQL) *model.Project {
+	proj := &model.Project{
+		ID:       uuid.New(),
+		Secret:   uuid.New(),
+		PublicID: uuid.New(),
+		Title:    "Test project title",
+	}
+	require.NoError(t, store.CreateProject(proj, nil))
+	return proj
+}
+
+func Test_Migrate(t *testing.T) {
+
+	t.Run("backfill project events", func(t *testing.T) {
+		store := NewInMemory()
+		proj := newProject(t, store)
+
+		exe := &model.TransactionExecution{
+			File:            model.File{ID: uuid.New(), ProjectID: proj.ID},
+			TransactionInfo: model.TransactionInfo{TransactionID: "1"},
+			BlockHeight:     2,
+			Events: []model.Event{
+				{Type: "A.0000000000000005.Test.Created", Values: []string{"1"}},
+				{Type: "flow.AccountCreated", Values: []string{"2"}},
+			},
+		}
+		require.NoError(t, store.InsertTransactionExecution(exe))
+
+		deploy := &model.ContractDeployment{
+			File:            model.File{ID: uuid.New(), ProjectID: proj.ID, Title: "Test"},
+			TransactionInfo: model.TransactionInfo{TransactionID: "2"},
+			BlockHeight:     3,
+			Events: []model.Event{
+				{Type: "flow.AccountContractAdded", Values: []string{"3"}},
+			},
+		}
+		require.NoError(t, store.InsertContractDeployment(deploy))
+
+		// history recorded before the events table existed
+		require.NoError(t, store.db.Migrator().DropTable(&model.ProjectEvent{}))
+
+		migrate(store.db)
+
+		var events []*model.ProjectEvent
+		var total int64
+		err := store.GetEventsForProject(proj.ID, model.EventFilter{Limit: model.DefaultEventsLimit}, &events, &total)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, int64(3), total)
+
+		assert.Equal(t, "A.0000000000000005.Test.Created", events[0].Type)
+		assert.Equal(t, "0000000000000005", events[0].ContractAddress)
+		assert.Equal(t, 2, events[0].BlockHeight)
+		assert.Equal(t, "flow.AccountContractAdded", events[2].Type)
+		assert.Equal(t, 3, events[2].BlockHeight)
+
+		// events are not inserted again once the table exists
+		migrate(store.db)
+
+		err = store.GetEventsForProject(proj.ID, model.EventFilter{Limit: model.DefaultEventsLimit}, &events, &total)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+}
+
+func Test_InsertTransactionExecution(t *testing.T) {
+
+	t.Run("concurrent inserts", func(t *testing.T) {
+		store := NewInMemory()
+		// each connection to the in-memory database opens a new database
+		d, err := store.db.DB()
+		require.NoError(t, err)
+		d.SetMaxOpenConns(1)
+
+		proj := newProject(t, store)
+
+		const count = 20
+
+		var wg sync.WaitGroup
+		for i := 0; i < count; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				exe := &model.TransactionExecution{
+					File: model.File{ID: uuid.New(), ProjectID: proj.ID},
+				}
+				assert.NoError(t, store.InsertTransactionExecution(exe))
+			}()
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, store.db.Transaction(func(tx *gorm.DB) error {
+					return store.rewindProjectState(tx, proj.ID)
+				}))
+			}()
+		}
+		wg.Wait()
+
+		var stored model.Project
+		require.NoError(t, store.GetProject(proj.ID, &stored))
+		assert.Equal(t, count, stored.TransactionExecutionCount)
+		assert.Equal(t, proj.StateVersion+count, stored.StateVersion)
+
+		var exes []*model.TransactionExecution
+		require.NoError(t, store.GetTransactionExecutionsForProject(proj.ID, &exes))
+		require.Len(t, exes, count)
+		for i, exe := range exes {
+			assert.Equal(t, i, exe.Index)
+		}
+	})
+
+	t.Run("missing project", func(t *testing.T) {
+		store := NewInMemory()
+
+		exe := &model.TransactionExecution{
+			File: model.File{ID: uuid.New(), ProjectID: uuid.New()},
+		}
+		assert.ErrorIs(t, store.InsertTransactionExecution(exe), gorm.ErrRecordNotFound)
+	})
+}
+
+func Test_InsertSnapshot(t *testing.T) {
+	store := NewInMemory()
+	proj := newProject(t, store)
+	other := newProject(t, store)
+
+	require.NoError(t, store.InsertSnapshot(&model.Snapshot{ProjectID: other.ID, BlockHeight: 1}))
+
+	for height := 1; height <= snapshotsPerProject+2; height++ {
+		require.NoError(t, store.InsertSnapshot(&model.Snapshot{ProjectID: proj.ID, BlockHeight: height}))
+	}
+
+	var heights []int
+	err := store.db.
+		Model(&model.Snapshot{}).
+		Where(&model.Snapshot{ProjectID: proj.ID}).
+		Order("block_height asc").
+		Pluck("block_height", &heights).
+		Error
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 4, 5}, heights)
+
+	var snapshot model.Snapshot
+	require.NoError(t, store.GetLatestSnapshot(other.ID, &snapshot))
+	assert.Equal(t, 1, snapshot.BlockHeight)
+}
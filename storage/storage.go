@@ -19,6 +19,7 @@
 package storage
 
 import (
+	"context"
 	"errors"
 	"github.com/Masterminds/semver"
 	"github.com/dapperlabs/flow-playground-api/model"
@@ -43,6 +44,10 @@ type Store interface {
 	GetAllProjectsForUser(userID uuid.UUID, proj *[]*model.Project) error
 	GetProjectCountForUser(userID uuid.UUID, count *int64) error
 	DeleteProject(id uuid.UUID) error
+	GetProjectStateVersions(ids []uuid.UUID, versions map[uuid.UUID]int64) error
+	// ListenStateInvalidations calls the handler every time the state of a project is rewound by any replica,
+	// until the context is done or the connection fails. It returns ErrNotSupported if the database can't notify.
+	ListenStateInvalidations(ctx context.Context, handler func(projectID uuid.UUID, stateVersion int64)) error
 
 	GetStaleProjects(stale time.Duration, projs *[]*model.Project) error
 	DeleteStaleProjects(stale time.Duration) error
@@ -68,7 +73,29 @@ type Store interface {
 	InsertScriptExecution(exe *model.ScriptExecution) error
 	GetScriptExecutionsForProject(projectID uuid.UUID, exes *[]*model.ScriptExecution) error
 
+	InsertExecutionBatch(
+		projectID uuid.UUID,
+		deployments []*model.ContractDeployment,
+		executions []*model.TransactionExecution,
+		scripts []*model.ScriptExecution,
+	) error
+
+	GetEventsForProject(
+		projectID uuid.UUID,
+		filter model.EventFilter,
+		events *[]*model.ProjectEvent,
+		total *int64,
+	) error
+
+	InsertOperation(operation *model.Operation) error
+	GetOperationsForProject(projectID uuid.UUID, operations *[]*model.Operation) error
+
+	InsertSnapshot(snapshot *model.Snapshot) error
+	GetLatestSnapshot(projectID uuid.UUID, snapshot *model.Snapshot) error
+
 	Ping() error
 }
 
 var ErrNotFound = errors.New("entity not found")
+
+var ErrNotSupported = errors.New("not supported by the database")
@@ -0,0 +1,50 @@
+/*
+ * Flow Playground
+ *
+ * Copyright 2019 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+// The emulator cache metrics are only recorded once the metrics are registered,
+// so the cache can be used without the server, e.g. in tests.
+
+// EmulatorCacheHit records a project emulator found in the cache.
+func EmulatorCacheHit() {
+	if emulatorCacheHits != nil {
+		emulatorCacheHits.Inc()
+	}
+}
+
+// EmulatorCacheMiss records a project emulator not found in the cache.
+func EmulatorCacheMiss() {
+	if emulatorCacheMisses != nil {
+		emulatorCacheMisses.Inc()
+	}
+}
+
+// EmulatorCacheEviction records a project emulator evicted from the cache to stay within its limits.
+func EmulatorCacheEviction() {
+	if emulatorCacheEvictions != nil {
+		emulatorCacheEvictions.Inc()
+	}
+}
+
+// SetEmulatorCacheBytes records the estimated memory used by the cached project emulators.
+func SetEmulatorCacheBytes(bytes int64) {
+	if emulatorCacheBytes != nil {
+		emulatorCacheBytes.Set(float64(bytes))
+	}
+}
@@ -46,6 +46,10 @@ var (
 	totalProjectGauge        prometheus.Gauge
 	ServerErrorCounter       prometheus.Counter
 	UserErrorCounter         prometheus.Counter
+	emulatorCacheHits        prometheus.Counter
+	emulatorCacheMisses      prometheus.Counter
+	emulatorCacheEvictions   prometheus.Counter
+	emulatorCacheBytes       prometheus.Gauge
 )
 
 type (
@@ -143,6 +147,32 @@ func RegisterOn(registerer prometheus.Registerer) {
 		},
 	)
 
+	emulatorCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "emulator_cache_hits_total",
+			Help: "Total number of project emulators found in the cache.",
+		},
+	)
+
+	emulatorCacheMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "emulator_cache_misses_total",
+			Help: "Total number of project emulators not found in the cache.",
+		},
+	)
+
+	emulatorCacheEvictions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "emulator_cache_evictions_total",
+			Help: "Total number of project emulators evicted from the cache to stay within its limits.",
+		},
+	)
+
+	emulatorCacheBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "emulator_cache_resident_bytes",
+		Help: "The estimated memory used by the cached project emulators.",
+	})
+
 	registerer.MustRegister(
 		requestStartedCounter,
 		requestCompletedCounter,
@@ -154,6 +184,10 @@ func RegisterOn(registerer prometheus.Registerer) {
 		totalProjectGauge,
 		ServerErrorCounter,
 		UserErrorCounter,
+		emulatorCacheHits,
+		emulatorCacheMisses,
+		emulatorCacheEvictions,
+		emulatorCacheBytes,
 	)
 }
 
@@ -175,6 +209,10 @@ func UnRegisterFrom(registerer prometheus.Registerer) {
 	registerer.Unregister(totalProjectGauge)
 	registerer.Unregister(ServerErrorCounter)
 	registerer.Unregister(UserErrorCounter)
+	registerer.Unregister(emulatorCacheHits)
+	registerer.Unregister(emulatorCacheMisses)
+	registerer.Unregister(emulatorCacheEvictions)
+	registerer.Unregister(emulatorCacheBytes)
 }
 
 func (a RequestsMetrics) ExtensionName() string {